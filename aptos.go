@@ -1,125 +1,102 @@
 package chain_selectors
 
 import (
-	_ "embed"
 	"fmt"
-	"log"
-
-	"gopkg.in/yaml.v3"
+	"strconv"
 )
 
+//go:generate go run genselectors.go
 //go:generate go run genchains_aptos.go
 //go:generate go run generate_all_selectors.go
 
-//go:embed selectors_aptos.yml
-var aptosSelectorsYml []byte
-
-var (
-	aptosSelectorsMap     = parseAptosYml(aptosSelectorsYml)
-	aptosChainsBySelector = make(map[uint64]AptosChain)
-)
+func validateAptosChainID(data map[uint64]ChainDetails) error {
+	return validateChains(data, validateAptosChain)
+}
 
-func init() {
-	// Load extra selectors
-	for chainID, chainDetails := range getExtraSelectors().Aptos {
-		if _, exists := aptosSelectorsMap[chainID]; exists {
-			log.Printf("WARN: Skipping extra selector for chain %d because it already exists", chainID)
-			continue
-		}
-		aptosSelectorsMap[chainID] = chainDetails
-		aptosChainsBySelector[chainDetails.ChainSelector] = AptosChain{
-			ChainID:     chainID,
-			Selector:    chainDetails.ChainSelector,
-			Name:        chainDetails.ChainName,
-			NetworkType: chainDetails.NetworkType,
-		}
+func validateAptosChain(chainID uint64, details ChainDetails) error {
+	if chainID == 0 {
+		return newLookupError(ErrInvalidChainID, nil, "invalid aptos chain ID: must be > 0")
 	}
-
-	for _, v := range AptosALL {
-		aptosChainsBySelector[v.Selector] = v
+	if details.ChainName == "" {
+		return newLookupError(ErrChainNameEmpty, nil, "chain name is empty for aptos chain %d", chainID)
+	}
+	if details.NetworkType == "" {
+		return newLookupError(ErrInvalidNetworkType, nil, "network type is empty for aptos chain %d", chainID)
 	}
+	return nil
 }
 
-func parseAptosYml(ymlFile []byte) map[uint64]ChainDetails {
-	type ymlData struct {
-		SelectorsByAptosChainId map[uint64]ChainDetails `yaml:"selectors"`
+func aptosChainFromEntry(e chainEntry) AptosChain {
+	return AptosChain{
+		ChainID:     e.uintChainID(),
+		Selector:    e.ChainDetails.ChainSelector,
+		Name:        e.name(),
+		NetworkType: e.ChainDetails.NetworkType,
+		Deprecated:  e.ChainDetails.Deprecated,
 	}
+}
 
-	var data ymlData
-	err := yaml.Unmarshal(ymlFile, &data)
-	if err != nil {
-		panic(err)
-	}
+func (c AptosChain) Family() string {
+	return FamilyAptos
+}
 
-	err = validateAptosChainID(data.SelectorsByAptosChainId)
-	if err != nil {
-		panic(err)
-	}
+func (c AptosChain) ChainIDString() string {
+	return strconv.FormatUint(c.ChainID, 10)
+}
 
-	return data.SelectorsByAptosChainId
+func (c AptosChain) GetSelector() uint64 {
+	return c.Selector
 }
 
-func validateAptosChainID(data map[uint64]ChainDetails) error {
-	seenSelectors := make(map[uint64]uint64) // selector -> chainID
-	for chainID, details := range data {
-		if chainID == 0 {
-			return fmt.Errorf("invalid aptos chain ID: must be > 0")
-		}
-		if details.ChainSelector == 0 {
-			return fmt.Errorf("invalid chain selector for aptos chain %d: must be > 0", chainID)
-		}
-		if details.ChainName == "" {
-			return fmt.Errorf("chain name is empty for aptos chain %d", chainID)
-		}
-		if details.NetworkType != NetworkTypeTestnet && details.NetworkType != NetworkTypeMainnet {
-			return fmt.Errorf("invalid network type %q for aptos chain %d: must be %q or %q",
-				details.NetworkType, chainID, NetworkTypeTestnet, NetworkTypeMainnet)
-		}
-		if existingChainID, exists := seenSelectors[details.ChainSelector]; exists {
-			return fmt.Errorf("duplicate chain selector %d: used by both aptos chain %d and %d",
-				details.ChainSelector, existingChainID, chainID)
-		}
-		seenSelectors[details.ChainSelector] = chainID
-	}
-	return nil
+func (c AptosChain) GetName() string {
+	return c.Name
+}
+
+func (c AptosChain) GetNetworkType() NetworkType {
+	return c.NetworkType
+}
+
+func (c AptosChain) IsDeprecated() bool {
+	return c.Deprecated
 }
 
 func AptosChainIdToChainSelector() map[uint64]uint64 {
-	copyMap := make(map[uint64]uint64, len(aptosSelectorsMap))
-	for k, v := range aptosSelectorsMap {
-		copyMap[k] = v.ChainSelector
+	entries := defaultRegistry.familyEntries(FamilyAptos)
+	copyMap := make(map[uint64]uint64, len(entries))
+	for _, e := range entries {
+		copyMap[e.uintChainID()] = e.ChainDetails.ChainSelector
 	}
 	return copyMap
 }
 
 func AptosNameFromChainId(chainId uint64) (string, error) {
-	details, exist := aptosSelectorsMap[chainId]
+	e, exist := defaultRegistry.entryByChainID(FamilyAptos, strconv.FormatUint(chainId, 10))
 	if !exist {
-		return "", fmt.Errorf("chain name not found for chain %v", chainId)
+		return "", newLookupError(ErrUnknownChainID{Family: FamilyAptos, ChainID: fmt.Sprint(chainId)}, nil, "chain name not found for chain %v", chainId)
 	}
-	if details.ChainName == "" {
-		return fmt.Sprint(chainId), nil
-	}
-	return details.ChainName, nil
+	return e.name(), nil
 }
 
 func AptosChainIdFromSelector(selector uint64) (uint64, error) {
-	chain, exist := aptosChainsBySelector[selector]
+	chain, exist := AptosChainBySelector(selector)
 	if !exist {
-		return 0, fmt.Errorf("chain id not found for selector %d", selector)
+		return 0, newLookupError(ErrUnknownSelector, nil, "chain id not found for selector %d", selector)
 	}
 
 	return chain.ChainID, nil
 }
 
 func AptosChainBySelector(selector uint64) (AptosChain, bool) {
-	chain, exist := aptosChainsBySelector[selector]
-	return chain, exist
+	e, exist := defaultRegistry.entry(selector)
+	if !exist || e.Family != FamilyAptos {
+		return AptosChain{}, false
+	}
+	return aptosChainFromEntry(e), true
 }
 
 func AptosNetworkTypeFromChainId(chainId uint64) (NetworkType, error) {
-	if chainDetails, exist := aptosSelectorsMap[chainId]; exist {
-		return chainDetails.NetworkType, nil
+	if e, exist := defaultRegistry.entryByChainID(FamilyAptos, strconv.FormatUint(chainId, 10)); exist {
+		return e.ChainDetails.NetworkType, nil
 	}
-	return "", fmt.Errorf("chain network type not found for chain %v", chainId)
+	return "", newLookupError(ErrUnknownChainID{Family: FamilyAptos, ChainID: fmt.Sprint(chainId)}, nil, "chain network type not found for chain %v", chainId)
 }
@@ -1,7 +1,6 @@
 package chain_selectors
 
 import (
-	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -48,7 +47,8 @@ func Test_AptosYmlAreValid(t *testing.T) {
 }
 
 func Test_AptosChainSelectors(t *testing.T) {
-	for selector, chain := range aptosChainsBySelector {
+	for _, chain := range AptosALL {
+		selector := chain.Selector
 		family, err := GetSelectorFamily(selector)
 		require.NoError(t, err,
 			"selector %v should be returned as aptos family, but received %v",
@@ -68,18 +68,18 @@ func Test_AptosChainSelectors(t *testing.T) {
 }
 
 func Test_AptosGetChainDetailsByChainIDAndFamily(t *testing.T) {
-	for k, v := range aptosSelectorsMap {
-		details, err := GetChainDetailsByChainIDAndFamily(fmt.Sprint(k), FamilyAptos)
+	for _, e := range aptosSelectors {
+		details, err := GetChainDetailsByChainIDAndFamily(e.ChainID, FamilyAptos)
 		assert.NoError(t, err)
-		assert.Equal(t, v, details)
+		assert.Equal(t, e.ChainDetails, details)
 	}
 }
 
 func Test_AptosGetChainIDByChainSelector(t *testing.T) {
-	for k, v := range aptosSelectorsMap {
-		chainID, err := GetChainIDFromSelector(v.ChainSelector)
+	for _, e := range aptosSelectors {
+		chainID, err := GetChainIDFromSelector(e.ChainDetails.ChainSelector)
 		assert.NoError(t, err)
-		assert.Equal(t, chainID, fmt.Sprintf("%v", k))
+		assert.Equal(t, chainID, e.ChainID)
 	}
 }
 
@@ -103,21 +103,34 @@ func Test_ValidateAptosChainID(t *testing.T) {
 		data := map[uint64]ChainDetails{
 			1: {ChainSelector: 0, ChainName: "aptos-mainnet", NetworkType: NetworkTypeMainnet},
 		}
-		assert.ErrorContains(t, validateAptosChainID(data), "invalid chain selector")
+		errs := Validate(ExtraSelectorsData{Aptos: data})
+		require.Len(t, errs, 1)
+		assert.ErrorContains(t, errs[0], "invalid chain selector")
 	})
 
 	t.Run("empty chain name fails", func(t *testing.T) {
 		data := map[uint64]ChainDetails{
 			1: {ChainSelector: 100, ChainName: "", NetworkType: NetworkTypeMainnet},
 		}
-		assert.ErrorContains(t, validateAptosChainID(data), "chain name is empty")
+		err := validateAptosChainID(data)
+		assert.ErrorIs(t, err, ErrChainNameEmpty)
+		assert.EqualError(t, err, "chain name is empty for aptos chain 1")
 	})
 
 	t.Run("invalid network type fails", func(t *testing.T) {
 		data := map[uint64]ChainDetails{
 			1: {ChainSelector: 100, ChainName: "aptos-mainnet", NetworkType: "invalid"},
 		}
-		assert.ErrorContains(t, validateAptosChainID(data), "invalid network type")
+		errs := Validate(ExtraSelectorsData{Aptos: data})
+		require.Len(t, errs, 1)
+		assert.ErrorIs(t, errs[0], ErrInvalidNetworkType)
+	})
+
+	t.Run("empty network type fails", func(t *testing.T) {
+		data := map[uint64]ChainDetails{
+			1: {ChainSelector: 100, ChainName: "aptos-mainnet"},
+		}
+		assert.ErrorIs(t, validateAptosChainID(data), ErrInvalidNetworkType)
 	})
 
 	t.Run("duplicate selector fails", func(t *testing.T) {
@@ -125,10 +138,13 @@ func Test_ValidateAptosChainID(t *testing.T) {
 			1: {ChainSelector: 100, ChainName: "aptos-mainnet", NetworkType: NetworkTypeMainnet},
 			2: {ChainSelector: 100, ChainName: "aptos-testnet", NetworkType: NetworkTypeTestnet},
 		}
-		assert.ErrorContains(t, validateAptosChainID(data), "duplicate chain selector")
+		errs := Validate(ExtraSelectorsData{Aptos: data})
+		require.Len(t, errs, 1)
+		assert.ErrorIs(t, errs[0], ErrSelectorInUse)
+		assert.ErrorContains(t, errs[0], "selector 100 is already used by aptos chain 1")
 	})
 
 	t.Run("existing aptos selectors are valid", func(t *testing.T) {
-		assert.NoError(t, validateAptosChainID(aptosSelectorsMap))
+		assert.NoError(t, validateAptosChainID(embeddedSelectors().Aptos))
 	})
 }
@@ -0,0 +1,87 @@
+package chain_selectors
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+)
+
+// benchmarkSizes are registry sizes used to show that lookups take constant time.
+var benchmarkSizes = []int{100, 1_000, 10_000}
+
+// newBenchmarkRegistry returns a registry holding size chains spread over the EVM and Solana families.
+func newBenchmarkRegistry(size int) *Registry {
+	data := ExtraSelectorsData{
+		Evm:    make(map[uint64]ChainDetails, size/2),
+		Solana: make(map[string]ChainDetails, size/2),
+	}
+	for i := 0; i < size/2; i++ {
+		data.Evm[uint64(i+1)] = ChainDetails{ChainSelector: uint64(i + 1), ChainName: "bench-evm-" + strconv.Itoa(i)}
+		data.Solana["bench-solana-"+strconv.Itoa(i)] = ChainDetails{ChainSelector: uint64(size + i + 1), ChainName: "bench-solana-" + strconv.Itoa(i)}
+	}
+	return NewRegistryFromData(data)
+}
+
+func BenchmarkRegistryLookups(b *testing.B) {
+	for _, size := range benchmarkSizes {
+		r := newBenchmarkRegistry(size)
+		// The last chain of the last family scanned by a linear search
+		selector := uint64(size + size/2)
+		name := fmt.Sprintf("bench-solana-%d", size/2-1)
+
+		b.Run(fmt.Sprintf("GetChainDetails/%d", size), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				if _, err := r.GetChainDetails(selector); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+		b.Run(fmt.Sprintf("GetChainIDFromSelector/%d", size), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				if _, err := r.GetChainIDFromSelector(selector); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+		b.Run(fmt.Sprintf("GetChainDetailsByNetworkName/%d", size), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				if _, err := r.GetChainDetailsByNetworkName(name); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkPackageLookups(b *testing.B) {
+	b.Run("ChainIdFromSelector", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			if _, err := ChainIdFromSelector(ETHEREUM_MAINNET.Selector); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	b.Run("ChainIdFromName", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			if _, err := ChainIdFromName(ETHEREUM_MAINNET.Name); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	b.Run("GetChainDetailsByNetworkName", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			if _, err := GetChainDetailsByNetworkName(ETHEREUM_MAINNET.Name); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
+
+// BenchmarkNewEmbeddedRegistry measures the work done at import time to build the default registry
+// from the generated tables.
+func BenchmarkNewEmbeddedRegistry(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		NewEmbeddedRegistry()
+	}
+}
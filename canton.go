@@ -1,113 +1,97 @@
 package chain_selectors
 
 import (
-	_ "embed"
 	"fmt"
-	"log"
-
-	"gopkg.in/yaml.v3"
+	"strings"
 )
 
+//go:generate go run genselectors.go
 //go:generate go run genchains_canton.go
 //go:generate go run generate_all_selectors.go
 
-//go:embed selectors_canton.yml
-var cantonSelectorsYml []byte
-
-var (
-	cantonChainsByChainId  = parseCantonYml(cantonSelectorsYml)
-	cantonChainsBySelector = loadAllCantonSelectors(cantonChainsByChainId)
-)
+func validateCantonChainID(data map[string]ChainDetails) error {
+	return validateChains(data, validateCantonChain)
+}
 
-func init() {
-	// Load extra selectors
-	for chainID, chainDetails := range getExtraSelectors().Canton {
-		if _, exists := cantonChainsByChainId[chainID]; exists {
-			log.Printf("WARN: Skipping extra selector for Canton chain %s because it already exists", chainID)
-			continue
-		}
-		cantonChainsByChainId[chainID] = chainDetails
-		cantonChainsBySelector[chainDetails.ChainSelector] = CantonChain{
-			ChainID:     chainID,
-			Selector:    chainDetails.ChainSelector,
-			Name:        chainDetails.ChainName,
-			NetworkType: chainDetails.NetworkType,
-		}
+func validateCantonChain(chainID string, _ ChainDetails) error {
+	if chainID == "" {
+		return newLookupError(ErrInvalidChainID, nil, "invalid canton chain ID: must not be empty")
 	}
-
-	err := validateCantonChainID(cantonChainsByChainId)
-	if err != nil {
-		panic(err)
+	if strings.ContainsAny(chainID, " \t\r\n") {
+		return newLookupError(ErrInvalidChainID, nil, "invalid canton chain ID %q: must not contain whitespace", chainID)
 	}
+	return nil
 }
 
-func parseCantonYml(ymlFile []byte) map[string]ChainDetails {
-	type ymlData struct {
-		SelectorsByName map[string]ChainDetails `yaml:"selectors"`
+func cantonChainFromEntry(e chainEntry) CantonChain {
+	return CantonChain{
+		ChainID:     e.ChainID,
+		Selector:    e.ChainDetails.ChainSelector,
+		Name:        e.ChainDetails.ChainName,
+		NetworkType: e.ChainDetails.NetworkType,
+		Deprecated:  e.ChainDetails.Deprecated,
 	}
+}
 
-	var data ymlData
-	if err := yaml.Unmarshal(ymlFile, &data); err != nil {
-		panic(err)
-	}
+func (c CantonChain) Family() string {
+	return FamilyCanton
+}
 
-	return data.SelectorsByName
+func (c CantonChain) ChainIDString() string {
+	return c.ChainID
 }
 
-func loadAllCantonSelectors(in map[string]ChainDetails) map[uint64]CantonChain {
-	output := make(map[uint64]CantonChain, len(cantonChainsByChainId))
-	for chainID, v := range in {
-		output[v.ChainSelector] = CantonChain{
-			ChainID:     chainID,
-			Selector:    v.ChainSelector,
-			Name:        v.ChainName,
-			NetworkType: v.NetworkType,
-		}
-	}
-	return output
+func (c CantonChain) GetSelector() uint64 {
+	return c.Selector
 }
 
-func validateCantonChainID(data map[string]ChainDetails) error {
-	// Add validation logic if needed
-	return nil
+func (c CantonChain) GetName() string {
+	return c.Name
+}
+
+func (c CantonChain) GetNetworkType() NetworkType {
+	return c.NetworkType
+}
+
+func (c CantonChain) IsDeprecated() bool {
+	return c.Deprecated
 }
 
 func CantonChainIdToChainSelector() map[string]uint64 {
-	copyMap := make(map[string]uint64, len(cantonChainsByChainId))
-	for k, v := range cantonChainsByChainId {
-		copyMap[k] = v.ChainSelector
-	}
-	return copyMap
+	return defaultRegistry.ChainIdToChainSelector(FamilyCanton)
 }
 
 func CantonNameFromChainId(chainID string) (string, error) {
-	details, exist := cantonChainsByChainId[chainID]
+	e, exist := defaultRegistry.entryByChainID(FamilyCanton, chainID)
 	if !exist {
-		return "", fmt.Errorf("chain name not found for chain: %v", chainID)
+		return "", newLookupError(ErrUnknownChainID{Family: FamilyCanton, ChainID: fmt.Sprint(chainID)}, nil, "chain name not found for chain: %v", chainID)
 	}
-	if details.ChainName == "" {
-		return "", fmt.Errorf("chain name is empty for chain: %v", chainID)
+	if e.ChainDetails.ChainName == "" {
+		return "", newLookupError(ErrChainNameEmpty, nil, "chain name is empty for %s chain: %v", FamilyCanton, chainID)
 	}
-	return details.ChainName, nil
+	return e.ChainDetails.ChainName, nil
 }
 
 func CantonChainIdFromSelector(selector uint64) (string, error) {
-	chain, exist := cantonChainsBySelector[selector]
+	chain, exist := CantonChainBySelector(selector)
 	if !exist {
-		return "", fmt.Errorf("chain not found for chain selector %d", selector)
+		return "", newLookupError(ErrUnknownSelector, nil, "chain not found for chain selector %d", selector)
 	}
 
 	return chain.ChainID, nil
 }
 
 func CantonChainBySelector(selector uint64) (CantonChain, bool) {
-	chain, exists := cantonChainsBySelector[selector]
-	return chain, exists
+	e, exists := defaultRegistry.entry(selector)
+	if !exists || e.Family != FamilyCanton {
+		return CantonChain{}, false
+	}
+	return cantonChainFromEntry(e), true
 }
 
 func CantonNetworkTypeFromChainId(chainId string) (NetworkType, error) {
-	if chainDetails, exist := cantonChainsByChainId[chainId]; exist {
-		return chainDetails.NetworkType, nil
+	if e, exist := defaultRegistry.entryByChainID(FamilyCanton, chainId); exist {
+		return e.ChainDetails.NetworkType, nil
 	}
-	return "", fmt.Errorf("chain network type not found for chain %v", chainId)
+	return "", newLookupError(ErrUnknownChainID{Family: FamilyCanton, ChainID: fmt.Sprint(chainId)}, nil, "chain network type not found for chain %v", chainId)
 }
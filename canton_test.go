@@ -1,7 +1,6 @@
 package chain_selectors
 
 import (
-	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -9,7 +8,8 @@ import (
 )
 
 func Test_CantonChainSelectors(t *testing.T) {
-	for selector, chain := range cantonChainsBySelector {
+	for _, chain := range CantonALL {
+		selector := chain.Selector
 		family, err := GetSelectorFamily(selector)
 		require.NoError(t, err,
 			"selector %v should be returned as canton family, but received %v",
@@ -29,17 +29,17 @@ func Test_CantonChainSelectors(t *testing.T) {
 }
 
 func Test_CantonGetChainDetailsByChainIDAndFamily(t *testing.T) {
-	for k, v := range cantonChainsByChainId {
-		details, err := GetChainDetailsByChainIDAndFamily(fmt.Sprint(k), FamilyCanton)
+	for _, e := range cantonSelectors {
+		details, err := GetChainDetailsByChainIDAndFamily(e.ChainID, FamilyCanton)
 		assert.NoError(t, err)
-		assert.Equal(t, v, details)
+		assert.Equal(t, e.ChainDetails, details)
 	}
 }
 
 func Test_CantonGetChainIDByChainSelector(t *testing.T) {
-	for k, v := range cantonChainsByChainId {
-		chainID, err := GetChainIDFromSelector(v.ChainSelector)
+	for _, e := range cantonSelectors {
+		chainID, err := GetChainIDFromSelector(e.ChainDetails.ChainSelector)
 		assert.NoError(t, err)
-		assert.Equal(t, chainID, fmt.Sprintf("%v", k))
+		assert.Equal(t, chainID, e.ChainID)
 	}
 }
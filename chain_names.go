@@ -0,0 +1,194 @@
+package chain_selectors
+
+import (
+	"strings"
+)
+
+// Network types of chain names. Local networks are not part of the naming convention but are parsed
+// so that the names of existing local chains can be checked.
+const (
+	chainNameMainnet  = "mainnet"
+	chainNameTestnet  = "testnet"
+	chainNameDevnet   = "devnet"
+	chainNameLocalnet = "localnet"
+)
+
+// relayChains are the blockchains whose legacy names hold a parachain right after the network type,
+// e.g. polkadot-testnet-astar-shibuya.
+var relayChains = map[string]bool{
+	"polkadot": true,
+	"kusama":   true,
+}
+
+// ChainName is a chain name split into the components of the <blockchain>-<type>-<network_instance>
+// naming convention, see ParseChainName.
+type ChainName struct {
+	// Blockchain is the name of the chain, which may span several components, e.g. "polygon-zkevm".
+	Blockchain string
+	// Type is the type of network, e.g. "mainnet" or "testnet".
+	Type string
+	// Instance identifies the network if it isn't a mainnet, e.g. "sepolia". Legacy names of parachains
+	// and rollups hold the instance of their parent network, e.g. "sepolia" for ethereum-testnet-sepolia-base-1.
+	Instance string
+	// Parachain is the parachain of legacy names of Polkadot and Kusama parachains, e.g. "moonbeam"
+	// for polkadot-mainnet-moonbeam.
+	Parachain string
+	// Rollups are the rollups of legacy names, from the one settling on the parent network to the last layer,
+	// e.g. arbitrum-1 then l3x-1 for ethereum-mainnet-arbitrum-1-l3x-1.
+	Rollups []ChainNameRollup
+}
+
+// ChainNameRollup is a rollup of a legacy chain name.
+type ChainNameRollup struct {
+	Name string
+	// Instance is the number of the rollup, if any, e.g. "1" for base-1.
+	Instance string
+}
+
+// Legacy reports whether the name follows the legacy
+// <blockchain>-<type>-<network_name>-<parachain>-<rollup>-<rollup_instance> pattern.
+func (n ChainName) Legacy() bool {
+	return n.Parachain != "" || len(n.Rollups) > 0
+}
+
+// String returns the chain name.
+func (n ChainName) String() string {
+	components := []string{n.Blockchain, n.Type}
+	if n.Parachain != "" {
+		components = append(components, n.Parachain)
+	}
+	if n.Instance != "" {
+		components = append(components, n.Instance)
+	}
+	for _, r := range n.Rollups {
+		components = append(components, r.Name)
+		if r.Instance != "" {
+			components = append(components, r.Instance)
+		}
+	}
+	return strings.Join(components, "-")
+}
+
+// ParseChainName splits a chain name into its components. The network type is the first component that is
+// mainnet, testnet, devnet or localnet, the components before it are the blockchain and the one after it, if
+// the network isn't a mainnet, is the instance. Any components left are the parachain and rollups of legacy names,
+// e.g. ethereum-testnet-sepolia-arbitrum-1 is the rollup arbitrum-1 settling on ethereum-testnet-sepolia.
+//
+// ParseChainName only fails on names it can't split, which wrap ErrInvalidChainName. Use ValidateChainName to
+// check that a name follows the naming convention.
+func ParseChainName(name string) (ChainName, error) {
+	if name == "" {
+		return ChainName{}, newLookupError(ErrInvalidChainName, nil, "chain name is empty")
+	}
+	for _, r := range name {
+		if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '_' && r != '-' {
+			return ChainName{}, newLookupError(ErrInvalidChainName, nil,
+				"chain name %s contains %q, only lowercase letters, digits, _ and - are allowed", name, r)
+		}
+	}
+	components := strings.Split(name, "-")
+	for _, c := range components {
+		if c == "" {
+			return ChainName{}, newLookupError(ErrInvalidChainName, nil, "chain name %s has an empty component", name)
+		}
+	}
+
+	typeIndex := -1
+	for i, c := range components {
+		if isChainNameType(c) {
+			typeIndex = i
+			break
+		}
+	}
+	if typeIndex < 0 {
+		return ChainName{}, newLookupError(ErrInvalidChainName, nil,
+			"chain name %s has no network type, expected one of mainnet, testnet or devnet", name)
+	}
+	if typeIndex == 0 {
+		return ChainName{}, newLookupError(ErrInvalidChainName, nil, "chain name %s has no blockchain", name)
+	}
+
+	parsed := ChainName{
+		Blockchain: strings.Join(components[:typeIndex], "-"),
+		Type:       components[typeIndex],
+	}
+	rest := components[typeIndex+1:]
+	if relayChains[parsed.Blockchain] && len(rest) > 0 {
+		parsed.Parachain, rest = rest[0], rest[1:]
+	}
+	if parsed.Type != chainNameMainnet && len(rest) > 0 {
+		parsed.Instance, rest = rest[0], rest[1:]
+	}
+	// Numbers following the instance are part of it, e.g. galileo-1, and are the instance of mainnets that have one
+	for len(rest) > 0 && isNumeric(rest[0]) {
+		if parsed.Instance != "" {
+			parsed.Instance += "-"
+		}
+		parsed.Instance += rest[0]
+		rest = rest[1:]
+	}
+
+	for len(rest) > 0 {
+		// A rollup name spans every component up to its number, e.g. polygon-zkevm-1
+		end := 0
+		for end < len(rest) && !isNumeric(rest[end]) {
+			end++
+		}
+		rollup := ChainNameRollup{Name: strings.Join(rest[:end], "-")}
+		if end < len(rest) {
+			rollup.Instance = rest[end]
+			end++
+		}
+		parsed.Rollups = append(parsed.Rollups, rollup)
+		rest = rest[end:]
+	}
+	return parsed, nil
+}
+
+// ValidateChainName returns the ways name doesn't follow the <blockchain>-<type>-<network_instance> naming
+// convention described in the README, or nil if it does. Every error wraps ErrInvalidChainName.
+// Names following the legacy pattern are reported too, so new chains don't use it. Their errors also wrap
+// ErrLegacyChainName.
+func ValidateChainName(name string) []error {
+	parsed, err := ParseChainName(name)
+	if err != nil {
+		return []error{err}
+	}
+
+	var errs []error
+	for _, c := range strings.Split(name, "-") {
+		if strings.HasPrefix(c, "_") || strings.HasSuffix(c, "_") || strings.Contains(c, "__") {
+			errs = append(errs, newLookupError(ErrInvalidChainName, nil,
+				"chain name %s has component %s which is not snake_case", name, c))
+		}
+	}
+	switch {
+	case parsed.Type == chainNameLocalnet:
+		errs = append(errs, newLookupError(ErrInvalidChainName, nil,
+			"chain name %s has network type %s, expected one of mainnet, testnet or devnet", name, parsed.Type))
+	case parsed.Type == chainNameMainnet && parsed.Instance != "":
+		errs = append(errs, newLookupError(ErrInvalidChainName, nil,
+			"chain name %s has network instance %s, which mainnets must not have", name, parsed.Instance))
+	case parsed.Type != chainNameMainnet && parsed.Instance == "":
+		errs = append(errs, newLookupError(ErrInvalidChainName, nil,
+			"chain name %s has no network instance, which %s names must have", name, parsed.Type))
+	}
+	if parsed.Legacy() {
+		errs = append(errs, newLookupError(ErrInvalidChainName, ErrLegacyChainName,
+			"chain name %s follows the legacy naming pattern", name))
+	}
+	return errs
+}
+
+func isChainNameType(component string) bool {
+	switch component {
+	case chainNameMainnet, chainNameTestnet, chainNameDevnet, chainNameLocalnet:
+		return true
+	default:
+		return false
+	}
+}
+
+func isNumeric(component string) bool {
+	return strings.Trim(component, "0123456789") == ""
+}
@@ -0,0 +1,81 @@
+package chain_selectors
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseChainName(t *testing.T) {
+	tests := []struct {
+		name     string
+		expected ChainName
+	}{
+		{name: "ethereum-mainnet", expected: ChainName{Blockchain: "ethereum", Type: "mainnet"}},
+		{name: "ethereum-testnet-sepolia", expected: ChainName{Blockchain: "ethereum", Type: "testnet", Instance: "sepolia"}},
+		{name: "polygon-zkevm-testnet-cardona", expected: ChainName{Blockchain: "polygon-zkevm", Type: "testnet", Instance: "cardona"}},
+		{name: "binance_smart_chain-testnet", expected: ChainName{Blockchain: "binance_smart_chain", Type: "testnet"}},
+		{name: "0g-testnet-galileo-1", expected: ChainName{Blockchain: "0g", Type: "testnet", Instance: "galileo-1"}},
+		{name: "mova-mainnet-2", expected: ChainName{Blockchain: "mova", Type: "mainnet", Instance: "2"}},
+		{name: "ethereum-mainnet-arbitrum-1", expected: ChainName{Blockchain: "ethereum", Type: "mainnet",
+			Rollups: []ChainNameRollup{{Name: "arbitrum", Instance: "1"}}}},
+		{name: "ethereum-testnet-sepolia-polygon-zkevm-1", expected: ChainName{Blockchain: "ethereum", Type: "testnet", Instance: "sepolia",
+			Rollups: []ChainNameRollup{{Name: "polygon-zkevm", Instance: "1"}}}},
+		{name: "ethereum-testnet-sepolia-arbitrum-1-treasure-1", expected: ChainName{Blockchain: "ethereum", Type: "testnet", Instance: "sepolia",
+			Rollups: []ChainNameRollup{{Name: "arbitrum", Instance: "1"}, {Name: "treasure", Instance: "1"}}}},
+		{name: "ethereum-testnet-hoodi-taiko", expected: ChainName{Blockchain: "ethereum", Type: "testnet", Instance: "hoodi",
+			Rollups: []ChainNameRollup{{Name: "taiko"}}}},
+		{name: "polkadot-mainnet-moonbeam", expected: ChainName{Blockchain: "polkadot", Type: "mainnet", Parachain: "moonbeam"}},
+		{name: "polkadot-testnet-astar-shibuya", expected: ChainName{Blockchain: "polkadot", Type: "testnet", Parachain: "astar", Instance: "shibuya"}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			parsed, err := ParseChainName(test.name)
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, parsed)
+			assert.Equal(t, test.name, parsed.String())
+			assert.Equal(t, len(test.expected.Rollups) > 0 || test.expected.Parachain != "", parsed.Legacy())
+		})
+	}
+
+	for _, name := range []string{"", "1000", "celo-sepolia", "mainnet", "Ethereum-Mainnet", "ethereum--mainnet", "ethereum mainnet"} {
+		_, err := ParseChainName(name)
+		assert.ErrorIs(t, err, ErrInvalidChainName, name)
+	}
+}
+
+func TestValidateChainName(t *testing.T) {
+	for _, name := range []string{"ethereum-mainnet", "ethereum-testnet-sepolia", "polygon-zkevm-testnet-cardona", "bsc-testnet-1", "coinex_smart_chain-devnet-1"} {
+		assert.Empty(t, ValidateChainName(name), name)
+	}
+
+	tests := []struct {
+		name     string
+		expected []string
+	}{
+		{name: "celo-sepolia", expected: []string{"chain name celo-sepolia has no network type, expected one of mainnet, testnet or devnet"}},
+		{name: "xdc-testnet", expected: []string{"chain name xdc-testnet has no network instance, which testnet names must have"}},
+		{name: "mova-mainnet-2", expected: []string{"chain name mova-mainnet-2 has network instance 2, which mainnets must not have"}},
+		{name: "aptos-localnet", expected: []string{"chain name aptos-localnet has network type localnet, expected one of mainnet, testnet or devnet"}},
+		{name: "ethereum-mainnet-arbitrum-1", expected: []string{"chain name ethereum-mainnet-arbitrum-1 follows the legacy naming pattern"}},
+		{name: "smart__chain-testnet", expected: []string{
+			"chain name smart__chain-testnet has component smart__chain which is not snake_case",
+			"chain name smart__chain-testnet has no network instance, which testnet names must have",
+		}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			errs := ValidateChainName(test.name)
+			messages := make([]string, 0, len(errs))
+			for _, err := range errs {
+				assert.ErrorIs(t, err, ErrInvalidChainName)
+				assert.Equal(t, strings.Contains(err.Error(), "legacy"), errors.Is(err, ErrLegacyChainName), err.Error())
+				messages = append(messages, err.Error())
+			}
+			assert.Equal(t, test.expected, messages)
+		})
+	}
+}
@@ -0,0 +1,324 @@
+// Command chainsel looks up chains of every family by selector, chain ID or name.
+//
+// Usage:
+//
+//	chainsel get [flags] <selector>
+//	chainsel find [flags] -family <family> -chain-id <chain id>
+//	chainsel name [flags] <network name>
+//	chainsel list [flags] [-family <family>] [-network-type mainnet|testnet] [-deprecated true|false]
+//
+// Every command accepts -format table|json|yaml and -remote, which adds the chains of the remote
+// all_selectors.yml file, see the remote package. -remote-url overrides its URL. Chains of
+// EXTRA_SELECTORS_FILE are included like in any program using the package. The exit code is 0 on
+// success, 1 when no chain matches and 2 on usage errors or when the chains can't be loaded.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"text/tabwriter"
+
+	"gopkg.in/yaml.v3"
+
+	chain_selectors "github.com/smartcontractkit/chain-selectors"
+	"github.com/smartcontractkit/chain-selectors/remote"
+)
+
+const usage = `Usage:
+  chainsel get [flags] <selector>
+  chainsel find [flags] -family <family> -chain-id <chain id>
+  chainsel name [flags] <network name>
+  chainsel list [flags] [-family <family>] [-network-type mainnet|testnet] [-deprecated true|false]
+
+Run 'chainsel <command> -h' for the flags of a command.`
+
+var families = []string{
+	chain_selectors.FamilyEVM,
+	chain_selectors.FamilySolana,
+	chain_selectors.FamilyStarknet,
+	chain_selectors.FamilyCosmos,
+	chain_selectors.FamilyAptos,
+	chain_selectors.FamilySui,
+	chain_selectors.FamilyTron,
+	chain_selectors.FamilyTon,
+	chain_selectors.FamilyCanton,
+	chain_selectors.FamilyStellar,
+}
+
+// chain is a single chain as printed by every command.
+type chain struct {
+	Family      string                      `json:"family" yaml:"family"`
+	ChainID     string                      `json:"chain_id" yaml:"chain_id"`
+	Selector    uint64                      `json:"selector" yaml:"selector"`
+	Name        string                      `json:"name" yaml:"name"`
+	NetworkType chain_selectors.NetworkType `json:"network_type" yaml:"network_type"`
+	Deprecated  bool                        `json:"deprecated" yaml:"deprecated"`
+}
+
+func newChain(info chain_selectors.ChainInfo) chain {
+	return chain{
+		Family:      info.Family(),
+		ChainID:     info.ChainIDString(),
+		Selector:    info.GetSelector(),
+		Name:        info.GetName(),
+		NetworkType: info.GetNetworkType(),
+		Deprecated:  info.IsDeprecated(),
+	}
+}
+
+// command holds the flags shared by every command.
+type command struct {
+	flags     *flag.FlagSet
+	format    *string
+	remote    *bool
+	remoteURL *string
+}
+
+func newCommand(name, args string, stderr io.Writer) *command {
+	flags := flag.NewFlagSet(name, flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	flags.Usage = func() {
+		fmt.Fprintf(stderr, "Usage: chainsel %s [flags] %s\n", name, args)
+		flags.PrintDefaults()
+	}
+	return &command{
+		flags:     flags,
+		format:    flags.String("format", "table", "output format, table, json or yaml"),
+		remote:    flags.Bool("remote", false, "include the chains of the remote all_selectors.yml file"),
+		remoteURL: flags.String("remote-url", remote.DefaultGitHubRawURL, "URL of the remote all_selectors.yml file, implies -remote"),
+	}
+}
+
+// parse parses the arguments of the command, which must leave nargs positional arguments.
+func (c *command) parse(args []string, nargs int) bool {
+	if err := c.flags.Parse(args); err != nil {
+		return false
+	}
+	if c.flags.NArg() != nargs || (*c.format != "table" && *c.format != "json" && *c.format != "yaml") {
+		c.flags.Usage()
+		return false
+	}
+	return true
+}
+
+// registry returns the registry to look chains up in.
+func (c *command) registry(ctx context.Context) (*chain_selectors.Registry, error) {
+	remoteURLSet := false
+	c.flags.Visit(func(f *flag.Flag) {
+		remoteURLSet = remoteURLSet || f.Name == "remote-url"
+	})
+	if !*c.remote && !remoteURLSet {
+		return chain_selectors.DefaultRegistry(), nil
+	}
+	return remote.NewClient(remote.WithURL(*c.remoteURL)).Registry(ctx)
+}
+
+func main() {
+	os.Exit(run(context.Background(), os.Args[1:], os.Stdout, os.Stderr))
+}
+
+func run(ctx context.Context, args []string, stdout, stderr io.Writer) int {
+	if len(args) == 0 {
+		fmt.Fprintln(stderr, usage)
+		return 2
+	}
+
+	switch args[0] {
+	case "get":
+		return runGet(ctx, args[1:], stdout, stderr)
+	case "find":
+		return runFind(ctx, args[1:], stdout, stderr)
+	case "name":
+		return runName(ctx, args[1:], stdout, stderr)
+	case "list":
+		return runList(ctx, args[1:], stdout, stderr)
+	case "help", "-h", "-help", "--help":
+		fmt.Fprintln(stdout, usage)
+		return 0
+	default:
+		fmt.Fprintf(stderr, "unknown command %q\n%s\n", args[0], usage)
+		return 2
+	}
+}
+
+func runGet(ctx context.Context, args []string, stdout, stderr io.Writer) int {
+	cmd := newCommand("get", "<selector>", stderr)
+	if !cmd.parse(args, 1) {
+		return 2
+	}
+	selector, err := strconv.ParseUint(cmd.flags.Arg(0), 10, 64)
+	if err != nil {
+		fmt.Fprintf(stderr, "invalid selector %q: must be an unsigned integer\n", cmd.flags.Arg(0))
+		return 2
+	}
+
+	return cmd.lookup(ctx, stdout, stderr, func(r *chain_selectors.Registry) (uint64, error) {
+		return selector, nil
+	})
+}
+
+func runFind(ctx context.Context, args []string, stdout, stderr io.Writer) int {
+	cmd := newCommand("find", "-family <family> -chain-id <chain id>", stderr)
+	family := cmd.flags.String("family", chain_selectors.FamilyEVM, "chain family")
+	chainID := cmd.flags.String("chain-id", "", "chain ID, formatted as for the family, e.g. decimal for EVM chains")
+	if !cmd.parse(args, 0) {
+		return 2
+	}
+	if *chainID == "" {
+		fmt.Fprintln(stderr, "-chain-id is required")
+		cmd.flags.Usage()
+		return 2
+	}
+
+	return cmd.lookup(ctx, stdout, stderr, func(r *chain_selectors.Registry) (uint64, error) {
+		details, err := r.GetChainDetailsByChainIDAndFamily(*chainID, *family)
+		return details.ChainSelector, err
+	})
+}
+
+func runName(ctx context.Context, args []string, stdout, stderr io.Writer) int {
+	cmd := newCommand("name", "<network name>", stderr)
+	if !cmd.parse(args, 1) {
+		return 2
+	}
+
+	return cmd.lookup(ctx, stdout, stderr, func(r *chain_selectors.Registry) (uint64, error) {
+		details, err := r.GetChainDetailsByNetworkName(cmd.flags.Arg(0))
+		return details.ChainSelector, err
+	})
+}
+
+// lookup prints the chain whose selector is returned by find.
+func (c *command) lookup(ctx context.Context, stdout, stderr io.Writer, find func(r *chain_selectors.Registry) (uint64, error)) int {
+	r, err := c.registry(ctx)
+	if err != nil {
+		fmt.Fprintf(stderr, "failed to load chains: %v\n", err)
+		return 2
+	}
+
+	selector, err := find(r)
+	if err != nil {
+		return lookupFailed(stderr, err)
+	}
+	info, err := r.GetChain(selector)
+	if err != nil {
+		return lookupFailed(stderr, err)
+	}
+	return c.print(stdout, stderr, newChain(info), []chain{newChain(info)})
+}
+
+func lookupFailed(stderr io.Writer, err error) int {
+	fmt.Fprintln(stderr, err)
+	if errors.Is(err, chain_selectors.ErrUnsupportedFamily) || errors.Is(err, chain_selectors.ErrInvalidChainID) {
+		return 2
+	}
+	return 1
+}
+
+func runList(ctx context.Context, args []string, stdout, stderr io.Writer) int {
+	cmd := newCommand("list", "[-family <family>] [-network-type mainnet|testnet] [-deprecated true|false]", stderr)
+	family := cmd.flags.String("family", "", "only list chains of this family")
+	networkType := cmd.flags.String("network-type", "", "only list chains of this network type, mainnet or testnet")
+	deprecated := cmd.flags.String("deprecated", "", "only list deprecated chains when true, or chains that are not deprecated when false")
+	if !cmd.parse(args, 0) {
+		return 2
+	}
+
+	listed := families
+	if *family != "" {
+		if !contains(families, *family) {
+			fmt.Fprintf(stderr, "unknown family %q\n", *family)
+			return 2
+		}
+		listed = []string{*family}
+	}
+	if *networkType != "" && *networkType != string(chain_selectors.NetworkTypeMainnet) && *networkType != string(chain_selectors.NetworkTypeTestnet) {
+		fmt.Fprintf(stderr, "invalid network type %q: must be mainnet or testnet\n", *networkType)
+		return 2
+	}
+	var wantDeprecated bool
+	if *deprecated != "" {
+		var err error
+		if wantDeprecated, err = strconv.ParseBool(*deprecated); err != nil {
+			fmt.Fprintf(stderr, "invalid -deprecated %q: must be true or false\n", *deprecated)
+			return 2
+		}
+	}
+
+	r, err := cmd.registry(ctx)
+	if err != nil {
+		fmt.Fprintf(stderr, "failed to load chains: %v\n", err)
+		return 2
+	}
+
+	chains := []chain{}
+	for _, f := range listed {
+		for _, selector := range r.ChainIdToChainSelector(f) {
+			info, err := r.GetChain(selector)
+			if err != nil {
+				continue
+			}
+			c := newChain(info)
+			if *networkType != "" && string(c.NetworkType) != *networkType {
+				continue
+			}
+			if *deprecated != "" && c.Deprecated != wantDeprecated {
+				continue
+			}
+			chains = append(chains, c)
+		}
+	}
+	sort.Slice(chains, func(i, j int) bool {
+		if chains[i].Family != chains[j].Family {
+			return chains[i].Family < chains[j].Family
+		}
+		return chains[i].Name < chains[j].Name
+	})
+
+	return cmd.print(stdout, stderr, chains, chains)
+}
+
+// print writes value as JSON or YAML, or the chains as a table.
+func (c *command) print(stdout, stderr io.Writer, value any, chains []chain) int {
+	var err error
+	switch *c.format {
+	case "json":
+		encoder := json.NewEncoder(stdout)
+		encoder.SetIndent("", "  ")
+		err = encoder.Encode(value)
+	case "yaml":
+		encoder := yaml.NewEncoder(stdout)
+		encoder.SetIndent(2)
+		if err = encoder.Encode(value); err == nil {
+			err = encoder.Close()
+		}
+	default:
+		w := tabwriter.NewWriter(stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "FAMILY\tCHAIN ID\tSELECTOR\tNAME\tNETWORK TYPE\tDEPRECATED")
+		for _, ch := range chains {
+			fmt.Fprintf(w, "%s\t%s\t%d\t%s\t%s\t%t\n", ch.Family, ch.ChainID, ch.Selector, ch.Name, ch.NetworkType, ch.Deprecated)
+		}
+		err = w.Flush()
+	}
+	if err != nil {
+		fmt.Fprintf(stderr, "failed to write output: %v\n", err)
+		return 2
+	}
+	return 0
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
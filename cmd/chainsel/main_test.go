@@ -0,0 +1,105 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+
+	chain_selectors "github.com/smartcontractkit/chain-selectors"
+)
+
+func TestRun(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("get as table", func(t *testing.T) {
+		var stdout, stderr bytes.Buffer
+		require.Equal(t, 0, run(ctx, []string{"get", "5009297550715157269"}, &stdout, &stderr))
+		assert.Contains(t, stdout.String(), "FAMILY")
+		assert.Regexp(t, `evm\s+1\s+5009297550715157269\s+ethereum-mainnet\s+mainnet\s+false`, stdout.String())
+	})
+
+	t.Run("find as json", func(t *testing.T) {
+		var stdout, stderr bytes.Buffer
+		require.Equal(t, 0, run(ctx, []string{"find", "-format", "json", "--family", "solana", "--chain-id", "5eykt4UsFv8P8NJdTREpY1vzqKqZKvdpKuc147dw2N9d"}, &stdout, &stderr))
+
+		var c chain
+		require.NoError(t, json.Unmarshal(stdout.Bytes(), &c))
+		assert.Equal(t, chain{
+			Family:      chain_selectors.FamilySolana,
+			ChainID:     "5eykt4UsFv8P8NJdTREpY1vzqKqZKvdpKuc147dw2N9d",
+			Selector:    124615329519749607,
+			Name:        "solana-mainnet",
+			NetworkType: chain_selectors.NetworkTypeMainnet,
+		}, c)
+	})
+
+	t.Run("name as yaml", func(t *testing.T) {
+		var stdout, stderr bytes.Buffer
+		require.Equal(t, 0, run(ctx, []string{"name", "-format", "yaml", "ton-mainnet"}, &stdout, &stderr))
+
+		var c chain
+		require.NoError(t, yaml.Unmarshal(stdout.Bytes(), &c))
+		assert.Equal(t, "-239", c.ChainID)
+		assert.Equal(t, chain_selectors.FamilyTon, c.Family)
+	})
+
+	t.Run("list with filters", func(t *testing.T) {
+		var stdout, stderr bytes.Buffer
+		require.Equal(t, 0, run(ctx, []string{"list", "-format", "json", "-family", "evm", "-network-type", "mainnet", "-deprecated=false"}, &stdout, &stderr))
+
+		var chains []chain
+		require.NoError(t, json.Unmarshal(stdout.Bytes(), &chains))
+		require.NotEmpty(t, chains)
+		for _, c := range chains {
+			assert.Equal(t, chain_selectors.FamilyEVM, c.Family)
+			assert.Equal(t, chain_selectors.NetworkTypeMainnet, c.NetworkType)
+			assert.False(t, c.Deprecated)
+		}
+		assert.Contains(t, chains, newChain(chain_selectors.ETHEREUM_MAINNET))
+	})
+
+	t.Run("remote", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte(`
+evm:
+  4242424242:
+    selector: 8888888888888888888
+    name: test-remote-chain
+    network_type: testnet
+`))
+		}))
+		defer server.Close()
+
+		var stdout, stderr bytes.Buffer
+		assert.Equal(t, 1, run(ctx, []string{"name", "test-remote-chain"}, &stdout, &stderr))
+		require.Equal(t, 0, run(ctx, []string{"name", "-remote", "-remote-url", server.URL, "test-remote-chain"}, &stdout, &stderr))
+		assert.Contains(t, stdout.String(), "8888888888888888888")
+	})
+
+	t.Run("lookup errors", func(t *testing.T) {
+		var stdout, stderr bytes.Buffer
+		assert.Equal(t, 1, run(ctx, []string{"get", "1"}, &stdout, &stderr))
+		assert.Contains(t, stderr.String(), "unknown chain selector 1")
+		assert.Equal(t, 1, run(ctx, []string{"find", "-chain-id", "4242424242"}, &stdout, &stderr))
+		assert.Equal(t, 2, run(ctx, []string{"find", "-family", "unknown", "-chain-id", "1"}, &stdout, &stderr))
+	})
+
+	t.Run("usage errors", func(t *testing.T) {
+		var stdout, stderr bytes.Buffer
+		assert.Equal(t, 2, run(ctx, nil, &stdout, &stderr))
+		assert.Equal(t, 2, run(ctx, []string{"unknown"}, &stdout, &stderr))
+		assert.Equal(t, 2, run(ctx, []string{"get"}, &stdout, &stderr))
+		assert.Equal(t, 2, run(ctx, []string{"get", "abc"}, &stdout, &stderr))
+		assert.Equal(t, 2, run(ctx, []string{"get", "-format", "xml", "1"}, &stdout, &stderr))
+		assert.Equal(t, 2, run(ctx, []string{"find", "-family", "evm"}, &stdout, &stderr))
+		assert.Equal(t, 2, run(ctx, []string{"list", "-family", "unknown"}, &stdout, &stderr))
+		assert.Equal(t, 2, run(ctx, []string{"list", "-deprecated", "maybe"}, &stdout, &stderr))
+	})
+}
@@ -0,0 +1,82 @@
+// Command immutability-check compares two snapshots of the chain selectors and fails when
+// an existing selector was modified, removed or moved to another chain.
+//
+// Usage:
+//
+//	immutability-check [-format text|json] <previous> <current>
+//
+// Snapshots are directories holding the selector files of every family, e.g. a checkout of
+// a previous git ref, or all_selectors.yml files. The exit code is 0 when selectors are
+// unchanged, 1 when immutability is violated and 2 when the snapshots can't be loaded.
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/smartcontractkit/chain-selectors/immutability"
+)
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdout, os.Stderr))
+}
+
+func run(args []string, stdout, stderr io.Writer) int {
+	flags := flag.NewFlagSet("immutability-check", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	format := flags.String("format", "text", "output format, text or json")
+	flags.Usage = func() {
+		fmt.Fprintln(stderr, "Usage: immutability-check [-format text|json] <previous> <current>")
+		flags.PrintDefaults()
+	}
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+	if flags.NArg() != 2 || (*format != "text" && *format != "json") {
+		flags.Usage()
+		return 2
+	}
+
+	previous, err := immutability.LoadSnapshot(flags.Arg(0))
+	if err != nil {
+		fmt.Fprintf(stderr, "failed to load previous snapshot: %v\n", err)
+		return 2
+	}
+	current, err := immutability.LoadSnapshot(flags.Arg(1))
+	if err != nil {
+		fmt.Fprintf(stderr, "failed to load current snapshot: %v\n", err)
+		return 2
+	}
+
+	report := immutability.Compare(previous, current)
+	if *format == "json" {
+		if report.Changes == nil {
+			report.Changes = []immutability.Change{}
+		}
+		encoder := json.NewEncoder(stdout)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(report); err != nil {
+			fmt.Fprintf(stderr, "failed to encode report: %v\n", err)
+			return 2
+		}
+	} else {
+		for _, change := range report.Changes {
+			level := "ERROR"
+			if !change.Kind.Violation() {
+				level = "WARN"
+			}
+			fmt.Fprintf(stdout, "%s: %s\n", level, change.Message)
+		}
+	}
+
+	if report.HasViolations() {
+		if *format == "text" {
+			fmt.Fprintln(stdout, "Selector immutability has been violated. Please ensure that existing selectors are not modified or removed.")
+		}
+		return 1
+	}
+	return 0
+}
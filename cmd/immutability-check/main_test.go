@@ -0,0 +1,53 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chain-selectors/immutability"
+)
+
+func TestRun(t *testing.T) {
+	modified := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(modified, "selectors_ton.yml"), []byte(`
+selectors:
+  -239:
+    selector: 1
+    name: ton-mainnet
+`), 0o600))
+
+	t.Run("unchanged", func(t *testing.T) {
+		var stdout, stderr bytes.Buffer
+		assert.Equal(t, 0, run([]string{"../..", "../.."}, &stdout, &stderr))
+		assert.Empty(t, stdout.String())
+	})
+
+	t.Run("violations as text", func(t *testing.T) {
+		var stdout, stderr bytes.Buffer
+		assert.Equal(t, 1, run([]string{"../..", modified}, &stdout, &stderr))
+		assert.Contains(t, stdout.String(), "ERROR: ton chain -239 changed selector from 16448340667252469081 to 1")
+		assert.Contains(t, stdout.String(), "ERROR: evm chain 1 with selector 5009297550715157269 was removed")
+	})
+
+	t.Run("violations as json", func(t *testing.T) {
+		var stdout, stderr bytes.Buffer
+		assert.Equal(t, 1, run([]string{"-format", "json", "../..", modified}, &stdout, &stderr))
+
+		var report immutability.Report
+		require.NoError(t, json.Unmarshal(stdout.Bytes(), &report))
+		assert.True(t, report.HasViolations())
+	})
+
+	t.Run("usage errors", func(t *testing.T) {
+		var stdout, stderr bytes.Buffer
+		assert.Equal(t, 2, run([]string{"../.."}, &stdout, &stderr))
+		assert.Equal(t, 2, run([]string{"-format", "xml", "../..", "../.."}, &stdout, &stderr))
+		assert.Equal(t, 2, run([]string{"../..", filepath.Join(modified, "missing")}, &stdout, &stderr))
+	})
+}
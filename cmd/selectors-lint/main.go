@@ -0,0 +1,88 @@
+// Command selectors-lint checks that the selector files follow the conventions of the README: chain names
+// follow the naming convention, network types agree with the names and the chains of selectors.yml are
+// sorted by chain ID within their section.
+//
+// Usage:
+//
+//	selectors-lint [-format text|json] [-allowlist <file>] [dir]
+//
+// dir holds the selector files of every family and defaults to the current directory. Names of the
+// allowlist, by default the legacy names listed in the lint package, are not checked against the naming
+// convention. The exit code is 0 when no issue is found, 1 when issues are found and 2 when the files
+// can't be loaded.
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/smartcontractkit/chain-selectors/lint"
+)
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdout, os.Stderr))
+}
+
+func run(args []string, stdout, stderr io.Writer) int {
+	flags := flag.NewFlagSet("selectors-lint", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	format := flags.String("format", "text", "output format, text or json")
+	allowlistPath := flags.String("allowlist", "", "file listing the names not checked against the naming convention, one per line")
+	flags.Usage = func() {
+		fmt.Fprintln(stderr, "Usage: selectors-lint [-format text|json] [-allowlist <file>] [dir]")
+		flags.PrintDefaults()
+	}
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+	if flags.NArg() > 1 || (*format != "text" && *format != "json") {
+		flags.Usage()
+		return 2
+	}
+	dir := "."
+	if flags.NArg() == 1 {
+		dir = flags.Arg(0)
+	}
+
+	allowlist := lint.DefaultAllowlist()
+	if *allowlistPath != "" {
+		var err error
+		if allowlist, err = lint.LoadAllowlist(*allowlistPath); err != nil {
+			fmt.Fprintf(stderr, "failed to load allowlist: %v\n", err)
+			return 2
+		}
+	}
+
+	report, err := lint.Lint(dir, allowlist)
+	if err != nil {
+		fmt.Fprintf(stderr, "failed to lint selector files: %v\n", err)
+		return 2
+	}
+
+	if *format == "json" {
+		if report.Issues == nil {
+			report.Issues = []lint.Issue{}
+		}
+		encoder := json.NewEncoder(stdout)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(report); err != nil {
+			fmt.Fprintf(stderr, "failed to encode report: %v\n", err)
+			return 2
+		}
+	} else {
+		for _, issue := range report.Issues {
+			fmt.Fprintf(stdout, "ERROR: %s\n", issue.Message)
+		}
+	}
+
+	if len(report.Issues) > 0 {
+		if *format == "text" {
+			fmt.Fprintln(stdout, "Selector files don't follow the conventions of the README. Please fix the chains listed above.")
+		}
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,57 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chain-selectors/lint"
+)
+
+func TestRun(t *testing.T) {
+	invalid := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(invalid, "selectors_aptos.yml"), []byte(`
+selectors:
+  1:
+    selector: 4741433654826277614
+    name: aptos-devnet
+    network_type: mainnet
+`), 0o600))
+	allowlist := filepath.Join(invalid, "allowlist.txt")
+	require.NoError(t, os.WriteFile(allowlist, []byte("aptos-devnet\n"), 0o600))
+
+	t.Run("repository", func(t *testing.T) {
+		var stdout, stderr bytes.Buffer
+		assert.Equal(t, 0, run([]string{"../.."}, &stdout, &stderr))
+		assert.Empty(t, stdout.String())
+	})
+
+	t.Run("issues as text", func(t *testing.T) {
+		var stdout, stderr bytes.Buffer
+		assert.Equal(t, 1, run([]string{invalid}, &stdout, &stderr))
+		assert.Contains(t, stdout.String(), "ERROR: selectors_aptos.yml:3: chain name aptos-devnet has no network instance, which devnet names must have")
+		assert.Contains(t, stdout.String(), "ERROR: selectors_aptos.yml:3: chain 1 has network_type mainnet but its name aptos-devnet is a devnet")
+	})
+
+	t.Run("issues as json", func(t *testing.T) {
+		var stdout, stderr bytes.Buffer
+		assert.Equal(t, 1, run([]string{"-format", "json", "-allowlist", allowlist, invalid}, &stdout, &stderr))
+
+		var report lint.Report
+		require.NoError(t, json.Unmarshal(stdout.Bytes(), &report))
+		require.Len(t, report.Issues, 1)
+		assert.Equal(t, lint.RuleNetworkType, report.Issues[0].Rule)
+	})
+
+	t.Run("usage errors", func(t *testing.T) {
+		var stdout, stderr bytes.Buffer
+		assert.Equal(t, 2, run([]string{"../..", "../.."}, &stdout, &stderr))
+		assert.Equal(t, 2, run([]string{"-format", "xml", "../.."}, &stdout, &stderr))
+		assert.Equal(t, 2, run([]string{"-allowlist", filepath.Join(invalid, "missing"), "../.."}, &stdout, &stderr))
+	})
+}
@@ -0,0 +1,113 @@
+package chain_selectors
+
+import (
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// runConcurrently calls read from several goroutines until write returns.
+// Run with -race to detect unsynchronized access.
+func runConcurrently(t *testing.T, read func(), write func()) {
+	done := make(chan struct{})
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				select {
+				case <-done:
+					return
+				default:
+					read()
+				}
+			}
+		}()
+	}
+
+	write()
+	close(done)
+	wg.Wait()
+}
+
+func TestPackageLookupsDuringWrites(t *testing.T) {
+	read := func() {
+		_, _ = GetChainDetails(ETHEREUM_MAINNET.Selector)
+		_, _ = GetChain(8888888888888888888)
+		_, _ = ChainBySelector(8888888888888888888)
+		_, _ = ChainByEvmChainID(4242424242)
+		_, _ = NameFromChainId(4242424242)
+		_, _ = GetChainDetailsByNetworkName("test-concurrent-chain")
+		_ = EvmChainIdToChainSelector()
+		_ = SolanaChainIdToChainSelector()
+		_ = TonChainIdToChainSelector()
+		_, _ = GetChainDetailsByChainIDAndFamily("test-concurrent-1", FamilyCosmos)
+	}
+
+	runConcurrently(t, read, func() {
+		for i := 0; i < 50; i++ {
+			require.NoError(t, Register(FamilyEVM, "4242424242", ChainDetails{ChainSelector: 8888888888888888888, ChainName: "test-concurrent-chain"}))
+			require.NoError(t, Unregister(FamilyEVM, "4242424242"))
+
+			require.NoError(t, LoadExtraSelectorsFromReader(strings.NewReader(`
+cosmos:
+  test-concurrent-1:
+    selector: 8888888888888888887
+    name: test-concurrent-cosmos
+`)))
+			require.NoError(t, Unregister(FamilyCosmos, "test-concurrent-1"))
+		}
+	})
+}
+
+func TestMergeIsAtomicForReaders(t *testing.T) {
+	r := NewRegistry()
+	data := ExtraSelectorsData{Evm: make(map[uint64]ChainDetails)}
+	for i := uint64(1); i <= 20; i++ {
+		data.Evm[i] = ChainDetails{ChainSelector: 1000 + i, ChainName: "test-chain-" + strconv.FormatUint(i, 10)}
+	}
+
+	read := func() {
+		// A merge is published at once, so readers see all of its chains or none
+		count := len(r.ChainIdToChainSelector(FamilyEVM))
+		assert.Contains(t, []int{0, len(data.Evm)}, count)
+		if _, err := r.GetChainDetails(1001); err == nil {
+			assert.Len(t, r.familyEntries(FamilyEVM), len(data.Evm))
+		}
+	}
+
+	runConcurrently(t, read, func() {
+		for i := 0; i < 50; i++ {
+			report, err := r.MergeExtraSelectors(data, ConflictPolicyError)
+			require.NoError(t, err)
+			require.Equal(t, len(data.Evm), report.Added)
+
+			require.NoError(t, r.update(func(s *registrySnapshot) error {
+				for _, e := range s.bySelector {
+					s.remove(e)
+				}
+				return nil
+			}))
+		}
+	})
+}
+
+func TestCloneIsIsolatedFromWrites(t *testing.T) {
+	r := NewEmbeddedRegistry()
+	clone := r.Clone()
+
+	runConcurrently(t, func() {
+		_, err := clone.GetChainDetails(8888888888888888888)
+		assert.Error(t, err)
+	}, func() {
+		for i := 0; i < 50; i++ {
+			require.NoError(t, r.Register(FamilyEVM, "4242424242", ChainDetails{ChainSelector: 8888888888888888888}))
+			require.NoError(t, r.Unregister(FamilyEVM, "4242424242"))
+		}
+	})
+}
@@ -0,0 +1,100 @@
+package chain_selectors
+
+import (
+	"fmt"
+	"strings"
+)
+
+//go:generate go run genselectors.go
+//go:generate go run genchains_cosmos.go
+//go:generate go run generate_all_selectors.go
+
+// cosmosMaxChainIDLength is the maximum chain-id length accepted by CometBFT.
+const cosmosMaxChainIDLength = 50
+
+func validateCosmosChainID(data map[string]ChainDetails) error {
+	return validateChains(data, validateCosmosChain)
+}
+
+func validateCosmosChain(chainID string, _ ChainDetails) error {
+	if chainID == "" {
+		return newLookupError(ErrInvalidChainID, nil, "invalid cosmos chain ID: must not be empty")
+	}
+	if len(chainID) > cosmosMaxChainIDLength {
+		return newLookupError(ErrInvalidChainID, nil, "invalid cosmos chain ID %s: must be at most %d characters", chainID, cosmosMaxChainIDLength)
+	}
+	if strings.ContainsAny(chainID, " \t\r\n") {
+		return newLookupError(ErrInvalidChainID, nil, "invalid cosmos chain ID %q: must not contain whitespace", chainID)
+	}
+	return nil
+}
+
+func cosmosChainFromEntry(e chainEntry) CosmosChain {
+	return CosmosChain{
+		ChainID:     e.ChainID,
+		Selector:    e.ChainDetails.ChainSelector,
+		Name:        e.name(),
+		NetworkType: e.ChainDetails.NetworkType,
+		Deprecated:  e.ChainDetails.Deprecated,
+	}
+}
+
+func (c CosmosChain) Family() string {
+	return FamilyCosmos
+}
+
+func (c CosmosChain) ChainIDString() string {
+	return c.ChainID
+}
+
+func (c CosmosChain) GetSelector() uint64 {
+	return c.Selector
+}
+
+func (c CosmosChain) GetName() string {
+	return c.Name
+}
+
+func (c CosmosChain) GetNetworkType() NetworkType {
+	return c.NetworkType
+}
+
+func (c CosmosChain) IsDeprecated() bool {
+	return c.Deprecated
+}
+
+func CosmosChainIdToChainSelector() map[string]uint64 {
+	return defaultRegistry.ChainIdToChainSelector(FamilyCosmos)
+}
+
+func CosmosNameFromChainId(chainId string) (string, error) {
+	e, exist := defaultRegistry.entryByChainID(FamilyCosmos, chainId)
+	if !exist {
+		return "", newLookupError(ErrUnknownChainID{Family: FamilyCosmos, ChainID: fmt.Sprint(chainId)}, nil, "chain name not found for chain %v", chainId)
+	}
+	return e.name(), nil
+}
+
+func CosmosChainIdFromSelector(selector uint64) (string, error) {
+	chain, exist := CosmosChainBySelector(selector)
+	if !exist {
+		return "", newLookupError(ErrUnknownSelector, nil, "chain not found for selector %d", selector)
+	}
+
+	return chain.ChainID, nil
+}
+
+func CosmosChainBySelector(selector uint64) (CosmosChain, bool) {
+	e, exists := defaultRegistry.entry(selector)
+	if !exists || e.Family != FamilyCosmos {
+		return CosmosChain{}, false
+	}
+	return cosmosChainFromEntry(e), true
+}
+
+func CosmosNetworkTypeFromChainId(chainId string) (NetworkType, error) {
+	if e, exist := defaultRegistry.entryByChainID(FamilyCosmos, chainId); exist {
+		return e.ChainDetails.NetworkType, nil
+	}
+	return "", newLookupError(ErrUnknownChainID{Family: FamilyCosmos, ChainID: fmt.Sprint(chainId)}, nil, "chain network type not found for chain %v", chainId)
+}
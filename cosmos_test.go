@@ -0,0 +1,105 @@
+package chain_selectors
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_CosmosChainSelectors(t *testing.T) {
+	for _, chain := range CosmosALL {
+		selector := chain.Selector
+		family, err := GetSelectorFamily(selector)
+		require.NoError(t, err,
+			"selector %v should be returned as cosmos family, but received %v",
+			selector, err)
+		require.NotEmpty(t, family)
+		require.Equal(t, FamilyCosmos, family)
+
+		id, err := CosmosChainIdFromSelector(selector)
+		require.Nil(t, err)
+		require.Equal(t, chain.ChainID, id)
+
+		returnedChain, exists := CosmosChainBySelector(selector)
+		require.True(t, exists)
+		require.Equal(t, chain, returnedChain)
+
+		name, err := CosmosNameFromChainId(chain.ChainID)
+		require.NoError(t, err)
+		require.Equal(t, chain.Name, name)
+
+		networkType, err := CosmosNetworkTypeFromChainId(chain.ChainID)
+		require.NoError(t, err)
+		require.Equal(t, chain.NetworkType, networkType)
+	}
+}
+
+func Test_CosmosGetChainDetailsByChainIDAndFamily(t *testing.T) {
+	for _, e := range cosmosSelectors {
+		details, err := GetChainDetailsByChainIDAndFamily(e.ChainID, FamilyCosmos)
+		assert.NoError(t, err)
+		assert.Equal(t, e.ChainDetails, details)
+	}
+}
+
+func Test_CosmosRegisteredChain(t *testing.T) {
+	// No Cosmos selector has been allocated yet, so the lookups are checked against a registered chain
+	chain := CosmosChain{ChainID: "cosmoshub-4", Selector: 6661234567890123456, Name: "cosmoshub-mainnet", NetworkType: NetworkTypeMainnet}
+	require.NoError(t, Register(FamilyCosmos, chain.ChainID, ChainDetails{ChainSelector: chain.Selector, ChainName: chain.Name, NetworkType: chain.NetworkType}))
+	t.Cleanup(func() { require.NoError(t, Unregister(FamilyCosmos, chain.ChainID)) })
+
+	returnedChain, exists := CosmosChainBySelector(chain.Selector)
+	require.True(t, exists)
+	assert.Equal(t, chain, returnedChain)
+
+	id, err := CosmosChainIdFromSelector(chain.Selector)
+	require.NoError(t, err)
+	assert.Equal(t, chain.ChainID, id)
+
+	name, err := CosmosNameFromChainId(chain.ChainID)
+	require.NoError(t, err)
+	assert.Equal(t, chain.Name, name)
+
+	networkType, err := CosmosNetworkTypeFromChainId(chain.ChainID)
+	require.NoError(t, err)
+	assert.Equal(t, chain.NetworkType, networkType)
+
+	details, err := GetChainDetailsByChainIDAndFamily(chain.ChainID, FamilyCosmos)
+	require.NoError(t, err)
+	assert.Equal(t, chain.Selector, details.ChainSelector)
+
+	family, err := GetSelectorFamily(chain.Selector)
+	require.NoError(t, err)
+	assert.Equal(t, FamilyCosmos, family)
+}
+
+func Test_CosmosGetChainIDByChainSelector(t *testing.T) {
+	for _, e := range cosmosSelectors {
+		chainID, err := GetChainIDFromSelector(e.ChainDetails.ChainSelector)
+		assert.NoError(t, err)
+		assert.Equal(t, e.ChainID, chainID)
+	}
+}
+
+func Test_ValidateCosmosChainID(t *testing.T) {
+	t.Run("existing cosmos selectors are valid", func(t *testing.T) {
+		assert.NoError(t, validateCosmosChainID(embeddedSelectors().Cosmos))
+	})
+
+	t.Run("empty chain ID fails", func(t *testing.T) {
+		data := map[string]ChainDetails{"": {ChainSelector: 1}}
+		assert.ErrorContains(t, validateCosmosChainID(data), "must not be empty")
+	})
+
+	t.Run("too long chain ID fails", func(t *testing.T) {
+		data := map[string]ChainDetails{strings.Repeat("a", 51): {ChainSelector: 1}}
+		assert.ErrorContains(t, validateCosmosChainID(data), "must be at most 50 characters")
+	})
+
+	t.Run("chain ID with whitespace fails", func(t *testing.T) {
+		data := map[string]ChainDetails{"cosmos hub-4": {ChainSelector: 1}}
+		assert.ErrorContains(t, validateCosmosChainID(data), "must not contain whitespace")
+	})
+}
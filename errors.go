@@ -0,0 +1,72 @@
+package chain_selectors
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	// ErrUnknownSelector is returned when no chain, or no chain of the expected family, has the given selector.
+	ErrUnknownSelector = errors.New("unknown chain selector")
+	// ErrUnknownChainName is returned when no chain has the given name.
+	ErrUnknownChainName = errors.New("unknown chain name")
+	// ErrUnsupportedFamily is returned for chain families this package does not support.
+	ErrUnsupportedFamily = errors.New("unsupported chain family")
+	// ErrInvalidChainID is returned when a chain ID is malformed for its family.
+	ErrInvalidChainID = errors.New("invalid chain id")
+	// ErrChainExists is returned when registering a chain ID that already exists for its family.
+	ErrChainExists = errors.New("chain already exists")
+	// ErrInvalidSelector is returned when registering or validating a chain whose selector is 0.
+	ErrInvalidSelector = errors.New("invalid chain selector")
+	// ErrSelectorInUse is returned when registering a chain with a selector used by another chain.
+	ErrSelectorInUse = errors.New("chain selector already in use")
+	// ErrNameInUse is returned when registering a chain with a name used by another chain.
+	ErrNameInUse = errors.New("chain name already in use")
+	// ErrChainNameEmpty is returned when a chain exists but has no name, or must have a name and has none.
+	ErrChainNameEmpty = errors.New("chain name is empty")
+	// ErrInvalidNetworkType is returned when a chain has a network type other than mainnet or testnet, or must
+	// have a network type and has none.
+	ErrInvalidNetworkType = errors.New("invalid network type")
+	// ErrInvalidChainName is returned for chain names that can't be parsed or don't follow the naming convention.
+	ErrInvalidChainName = errors.New("invalid chain name")
+	// ErrLegacyChainName is returned, along with ErrInvalidChainName, for chain names following the legacy naming pattern.
+	ErrLegacyChainName = errors.New("legacy chain name")
+)
+
+// ErrUnknownChainID is returned when a family has no chain with the given chain ID.
+// Use errors.As to retrieve it:
+//
+//	var unknown chain_selectors.ErrUnknownChainID
+//	if errors.As(err, &unknown) { ... }
+type ErrUnknownChainID struct {
+	Family  string
+	ChainID string
+}
+
+func (e ErrUnknownChainID) Error() string {
+	return fmt.Sprintf("unknown %s chain id %s", e.Family, e.ChainID)
+}
+
+// lookupError keeps the message lookups have always returned while exposing
+// the sentinel or typed errors describing the failure to errors.Is and errors.As.
+type lookupError struct {
+	message string
+	errs    []error
+}
+
+func (e *lookupError) Error() string {
+	return e.message
+}
+
+func (e *lookupError) Unwrap() []error {
+	return e.errs
+}
+
+// newLookupError returns an error with the formatted message wrapping err and, if not nil, cause.
+func newLookupError(err error, cause error, format string, args ...any) error {
+	errs := []error{err}
+	if cause != nil {
+		errs = append(errs, cause)
+	}
+	return &lookupError{message: fmt.Sprintf(format, args...), errs: errs}
+}
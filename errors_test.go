@@ -0,0 +1,77 @@
+package chain_selectors
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLookupErrors(t *testing.T) {
+	t.Run("unknown selector", func(t *testing.T) {
+		_, err := GetChainDetails(1)
+		require.Error(t, err)
+		assert.ErrorIs(t, err, ErrUnknownSelector)
+		assert.Equal(t, "unknown chain selector 1", err.Error())
+
+		_, err = ChainIdFromSelector(1)
+		assert.ErrorIs(t, err, ErrUnknownSelector)
+
+		_, err = IsEvm(1)
+		assert.ErrorIs(t, err, ErrUnknownSelector)
+	})
+
+	t.Run("unknown chain name", func(t *testing.T) {
+		_, err := ChainIdFromName("not-a-chain")
+		assert.ErrorIs(t, err, ErrUnknownChainName)
+
+		_, err = GetChainDetailsByNetworkName("not-a-chain")
+		assert.ErrorIs(t, err, ErrUnknownChainName)
+	})
+
+	t.Run("unknown chain id", func(t *testing.T) {
+		_, err := NameFromChainId(4242424242)
+		var unknown ErrUnknownChainID
+		require.ErrorAs(t, err, &unknown)
+		assert.Equal(t, ErrUnknownChainID{Family: FamilyEVM, ChainID: "4242424242"}, unknown)
+
+		_, err = GetChainDetailsByChainIDAndFamily("unknown-chain", FamilySolana)
+		require.ErrorAs(t, err, &unknown)
+		assert.Equal(t, FamilySolana, unknown.Family)
+		assert.Equal(t, "invalid chain id unknown-chain for solana", err.Error())
+	})
+
+	t.Run("invalid chain id", func(t *testing.T) {
+		_, err := GetChainDetailsByChainIDAndFamily("not-a-number", FamilyEVM)
+		assert.ErrorIs(t, err, ErrInvalidChainID)
+		var numErr *strconv.NumError
+		assert.ErrorAs(t, err, &numErr, "the parse error is kept as cause")
+		assert.False(t, errors.As(err, new(ErrUnknownChainID)))
+	})
+
+	t.Run("chain without a name", func(t *testing.T) {
+		require.NoError(t, Register(FamilyCanton, "NamelessNet", ChainDetails{ChainSelector: 4242}))
+		t.Cleanup(func() { require.NoError(t, Unregister(FamilyCanton, "NamelessNet")) })
+
+		_, err := CantonNameFromChainId("NamelessNet")
+		assert.ErrorIs(t, err, ErrChainNameEmpty)
+		assert.NotErrorIs(t, err, ErrUnknownChainName)
+		assert.EqualError(t, err, "chain name is empty for canton chain: NamelessNet")
+	})
+
+	t.Run("invalid selector", func(t *testing.T) {
+		err := NewRegistry().Register(FamilyEVM, "4242", ChainDetails{})
+		assert.ErrorIs(t, err, ErrInvalidSelector)
+
+		errs := Validate(ExtraSelectorsData{Evm: map[uint64]ChainDetails{4242: {}}})
+		require.Len(t, errs, 1)
+		assert.ErrorIs(t, errs[0], ErrInvalidSelector)
+	})
+
+	t.Run("unsupported family", func(t *testing.T) {
+		_, err := GetChainDetailsByChainIDAndFamily("1", "not-a-family")
+		assert.ErrorIs(t, err, ErrUnsupportedFamily)
+	})
+}
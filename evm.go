@@ -1,164 +1,164 @@
 package chain_selectors
 
 import (
-	_ "embed"
 	"fmt"
-	"log"
 	"strconv"
-
-	"gopkg.in/yaml.v3"
 )
 
+//go:generate go run genselectors.go
 //go:generate go run genchains_evm.go
 //go:generate go run generate_all_selectors.go
 
-//go:embed selectors.yml
-var selectorsYml []byte
-
-//go:embed test_selectors.yml
-var testSelectorsYml []byte
-
-var (
-	evmSelectorsMap           = parseYml(selectorsYml)
-	evmTestSelectorsMap       = parseYml(testSelectorsYml)
-	evmChainIdToChainSelector = loadAllEVMSelectors()
-	evmChainsBySelector       = make(map[uint64]Chain)
-	evmChainsByEvmChainID     = make(map[uint64]Chain)
-)
-
-func init() {
-	// Load extra selectors
-	for chainID, chainDetails := range getExtraSelectors().Evm {
-		if _, exists := evmSelectorsMap[chainID]; exists {
-			log.Printf("WARN: Skipping extra selector for chain %d because it already exists", chainID)
-			continue
-		}
+func validateEVMChainID(data map[uint64]ChainDetails) error {
+	return validateChains(data, validateEVMChain)
+}
 
-		evmSelectorsMap[chainID] = chainDetails
-		evmChainIdToChainSelector[chainID] = chainDetails
-		chain := Chain{
-			EvmChainID: chainID,
-			Selector:   chainDetails.ChainSelector,
-			Name:       chainDetails.ChainName,
-		}
-		evmChainsBySelector[chainDetails.ChainSelector] = chain
-		evmChainsByEvmChainID[chainID] = chain
+func validateEVMChain(chainID uint64, _ ChainDetails) error {
+	if chainID == 0 {
+		return newLookupError(ErrInvalidChainID, nil, "invalid evm chain ID: must be > 0")
 	}
+	return nil
+}
 
-	for _, ch := range ALL {
-		evmChainsBySelector[ch.Selector] = ch
-		evmChainsByEvmChainID[ch.EvmChainID] = ch
+func evmChainFromEntry(e chainEntry) Chain {
+	return Chain{
+		EvmChainID:  e.uintChainID(),
+		Selector:    e.ChainDetails.ChainSelector,
+		Name:        e.name(),
+		NetworkType: e.ChainDetails.NetworkType,
+		Deprecated:  e.ChainDetails.Deprecated,
 	}
 }
 
-func loadAllEVMSelectors() map[uint64]ChainDetails {
-	output := make(map[uint64]ChainDetails, len(evmSelectorsMap)+len(evmTestSelectorsMap))
-	for k, v := range evmSelectorsMap {
-		output[k] = v
-	}
-	for k, v := range evmTestSelectorsMap {
-		v.NetworkType = NetworkTypeTestnet
-		output[k] = v
-	}
-	return output
+func (c Chain) Family() string {
+	return FamilyEVM
 }
 
-func parseYml(ymlFile []byte) map[uint64]ChainDetails {
-	type ymlData struct {
-		SelectorsByEvmChainId map[uint64]ChainDetails `yaml:"selectors"`
-	}
+func (c Chain) ChainIDString() string {
+	return strconv.FormatUint(c.EvmChainID, 10)
+}
 
-	var data ymlData
-	err := yaml.Unmarshal(ymlFile, &data)
-	if err != nil {
-		panic(err)
-	}
+func (c Chain) GetSelector() uint64 {
+	return c.Selector
+}
+
+func (c Chain) GetName() string {
+	return c.Name
+}
+
+func (c Chain) GetNetworkType() NetworkType {
+	return c.NetworkType
+}
 
-	return data.SelectorsByEvmChainId
+func (c Chain) IsDeprecated() bool {
+	return c.Deprecated
 }
 
 func EvmChainIdToChainSelector() map[uint64]uint64 {
-	copyMap := make(map[uint64]uint64, len(evmChainIdToChainSelector))
-	for k, v := range evmChainIdToChainSelector {
-		copyMap[k] = v.ChainSelector
+	entries := defaultRegistry.familyEntries(FamilyEVM)
+	copyMap := make(map[uint64]uint64, len(entries))
+	for _, e := range entries {
+		copyMap[e.uintChainID()] = e.ChainDetails.ChainSelector
 	}
 	return copyMap
 }
 
 func EvmNetworkTypeFromChainId(chainId uint64) (NetworkType, error) {
-	if chainDetails, exist := evmChainIdToChainSelector[chainId]; exist {
-		return chainDetails.NetworkType, nil
+	if e, exist := defaultRegistry.entryByChainID(FamilyEVM, strconv.FormatUint(chainId, 10)); exist {
+		return e.ChainDetails.NetworkType, nil
 	}
-	return "", fmt.Errorf("chain network type not found for chain %d", chainId)
+	return "", newLookupError(ErrUnknownChainID{Family: FamilyEVM, ChainID: fmt.Sprint(chainId)}, nil, "chain network type not found for chain %d", chainId)
 }
 
 // Deprecated, this only supports EVM chains, use the chain agnostic `GetChainIDFromSelector` instead
 func ChainIdFromSelector(chainSelectorId uint64) (uint64, error) {
-	for k, v := range evmChainIdToChainSelector {
-		if v.ChainSelector == chainSelectorId {
-			return k, nil
-		}
+	if e, exist := defaultRegistry.entry(chainSelectorId); exist && e.Family == FamilyEVM {
+		return e.uintChainID(), nil
 	}
-	return 0, fmt.Errorf("chain not found for chain selector %d", chainSelectorId)
+	return 0, newLookupError(ErrUnknownSelector, nil, "chain not found for chain selector %d", chainSelectorId)
 }
 
 // Deprecated, this only supports EVM chains, use the chain agnostic `GetChainDetailsByChainIDAndFamily` instead
 func SelectorFromChainId(chainId uint64) (uint64, error) {
-	if chainSelectorId, exist := evmChainIdToChainSelector[chainId]; exist {
-		return chainSelectorId.ChainSelector, nil
+	if e, exist := defaultRegistry.entryByChainID(FamilyEVM, strconv.FormatUint(chainId, 10)); exist {
+		return e.ChainDetails.ChainSelector, nil
 	}
-	return 0, fmt.Errorf("chain selector not found for chain %d", chainId)
+	return 0, newLookupError(ErrUnknownChainID{Family: FamilyEVM, ChainID: fmt.Sprint(chainId)}, nil, "chain selector not found for chain %d", chainId)
 }
 
 // Deprecated, this only supports EVM chains, use the chain agnostic `GetChainDetailsByChainIDAndFamily` instead
 func NameFromChainId(chainId uint64) (string, error) {
-	details, exist := evmChainIdToChainSelector[chainId]
+	e, exist := defaultRegistry.entryByChainID(FamilyEVM, strconv.FormatUint(chainId, 10))
 	if !exist {
-		return "", fmt.Errorf("chain name not found for chain %d", chainId)
+		return "", newLookupError(ErrUnknownChainID{Family: FamilyEVM, ChainID: fmt.Sprint(chainId)}, nil, "chain name not found for chain %d", chainId)
 	}
-	if details.ChainName == "" {
-		return strconv.FormatUint(chainId, 10), nil
-	}
-	return details.ChainName, nil
+	return e.name(), nil
 }
 
 func ChainIdFromName(name string) (uint64, error) {
-	for k, v := range evmChainIdToChainSelector {
-		if v.ChainName == name {
-			return k, nil
-		}
+	s := defaultRegistry.snapshot()
+	if e, exist := s.evmEntryByName(name); exist {
+		return e.uintChainID(), nil
+	}
+	return 0, newUnknownNameError(s, name, func(e chainEntry) bool { return e.Family == FamilyEVM },
+		"chain not found for name %s", name)
+}
+
+// ChainByName returns the EVM chain with the given name. Like ChainIdFromName, chains without a name
+// are found by their chain ID, but no similar names are searched when the chain does not exist.
+func ChainByName(name string) (Chain, bool) {
+	e, exists := defaultRegistry.snapshot().evmEntryByName(name)
+	if !exists {
+		return Chain{}, false
+	}
+	return evmChainFromEntry(e), true
+}
+
+// evmEntryByName returns the EVM chain with the given name, or with the given chain ID if it has no name.
+func (s *registrySnapshot) evmEntryByName(name string) (chainEntry, bool) {
+	if e, exist := s.entryByName(name); exist && e.Family == FamilyEVM {
+		return e, true
 	}
-	chainId, err := strconv.ParseUint(name, 10, 64)
-	if err == nil {
-		if details, exist := evmChainIdToChainSelector[chainId]; exist && details.ChainName == "" {
-			return chainId, nil
+	if _, err := strconv.ParseUint(name, 10, 64); err == nil {
+		if e, exist := s.entryByChainID(FamilyEVM, name); exist && e.ChainDetails.ChainName == "" {
+			return e, true
 		}
 	}
-	return 0, fmt.Errorf("chain not found for name %s", name)
+	return chainEntry{}, false
 }
 
 func TestChainIds() []uint64 {
-	chainIds := make([]uint64, 0, len(evmTestSelectorsMap))
-	for k := range evmTestSelectorsMap {
-		chainIds = append(chainIds, k)
+	chainIds := make([]uint64, 0, len(evmTestSelectors))
+	for _, e := range evmTestSelectors {
+		chainIds = append(chainIds, e.uintChainID())
 	}
 	return chainIds
 }
 
+// ChainBySelector returns the EVM chain with the given selector. Like ALL, it covers the chains of selectors.yml
+// and test_selectors.yml, as well as extra selectors and registered chains.
 func ChainBySelector(sel uint64) (Chain, bool) {
-	ch, exists := evmChainsBySelector[sel]
-	return ch, exists
+	e, exists := defaultRegistry.entry(sel)
+	if !exists || e.Family != FamilyEVM {
+		return Chain{}, false
+	}
+	return evmChainFromEntry(e), true
 }
 
+// ChainByEvmChainID returns the EVM chain with the given chain ID. Like ALL, it covers the chains of selectors.yml
+// and test_selectors.yml, as well as extra selectors and registered chains.
 func ChainByEvmChainID(evmChainID uint64) (Chain, bool) {
-	ch, exists := evmChainsByEvmChainID[evmChainID]
-	return ch, exists
+	e, exists := defaultRegistry.entryByChainID(FamilyEVM, strconv.FormatUint(evmChainID, 10))
+	if !exists {
+		return Chain{}, false
+	}
+	return evmChainFromEntry(e), true
 }
 
 func IsEvm(chainSel uint64) (bool, error) {
 	_, exists := ChainBySelector(chainSel)
 	if !exists {
-		return false, fmt.Errorf("chain %d not found", chainSel)
+		return false, newLookupError(ErrUnknownSelector, nil, "chain %d not found", chainSel)
 	}
 	// We always return true since only evm chains are supported atm.
 	return true, nil
@@ -1,7 +1,6 @@
 package chain_selectors
 
 import (
-	"fmt"
 	"math/rand"
 	"strconv"
 	"testing"
@@ -13,18 +12,22 @@ import (
 func TestNoSameChainSelectorsAreGenerated(t *testing.T) {
 	chainSelectors := map[uint64]struct{}{}
 
-	for k, v := range evmChainIdToChainSelector {
-		selector := v.ChainSelector
+	for _, e := range append(evmSelectors, evmTestSelectors...) {
+		selector := e.ChainDetails.ChainSelector
 		_, exist := chainSelectors[selector]
-		assert.False(t, exist, "Chain Selectors should be unique. Selector %d is duplicated for chain %d", selector, k)
+		assert.False(t, exist, "Chain Selectors should be unique. Selector %d is duplicated for chain %s", selector, e.ChainID)
 		chainSelectors[selector] = struct{}{}
 	}
 }
 
 func TestNoOverlapBetweenRealAndTestChains(t *testing.T) {
-	for k, _ := range evmSelectorsMap {
-		_, exist := evmTestSelectorsMap[k]
-		assert.False(t, exist, "Chain %d is duplicated between real and test chains", k)
+	testChainIDs := map[string]struct{}{}
+	for _, e := range evmTestSelectors {
+		testChainIDs[e.ChainID] = struct{}{}
+	}
+	for _, e := range evmSelectors {
+		_, exist := testChainIDs[e.ChainID]
+		assert.False(t, exist, "Chain %s is duplicated between real and test chains", e.ChainID)
 	}
 }
 
@@ -116,11 +119,12 @@ func Test_ChainSelectors(t *testing.T) {
 
 func Test_TestChainIds(t *testing.T) {
 	chainIds := TestChainIds()
-	assert.Equal(t, len(chainIds), len(evmTestSelectorsMap), "Should return correct number of test chain ids")
+	assert.Equal(t, len(chainIds), len(evmTestSelectors), "Should return correct number of test chain ids")
 
 	for _, chainId := range chainIds {
-		_, exist := evmTestSelectorsMap[chainId]
+		e, exist := DefaultRegistry().entryByChainID(FamilyEVM, strconv.FormatUint(chainId, 10))
 		assert.True(t, exist)
+		assert.Contains(t, evmTestSelectors, e)
 	}
 }
 
@@ -168,13 +172,17 @@ func Test_ChainNames(t *testing.T) {
 		t.Run(test.name, func(t *testing.T) {
 			chainId, err1 := ChainIdFromName(test.chainName)
 			chainName, err2 := NameFromChainId(test.chainId)
+			ch, exists := ChainByName(test.chainName)
 			if test.expectErr {
 				require.Error(t, err1)
 				require.Error(t, err2)
+				assert.False(t, exists)
 				return
 			}
 			require.NoError(t, err1)
 			assert.Equal(t, test.chainId, chainId)
+			assert.True(t, exists)
+			assert.Equal(t, test.chainId, ch.EvmChainID)
 
 			require.NoError(t, err2)
 			assert.Equal(t, test.chainName, chainName)
@@ -195,6 +203,14 @@ func Test_ChainBySelector(t *testing.T) {
 		_, exists := ChainBySelector(rand.Uint64())
 		assert.False(t, exists)
 	})
+
+	t.Run("test chain", func(t *testing.T) {
+		// Chains of test_selectors.yml are part of ALL and returned too
+		v, exists := ChainBySelector(GETH_DEVNET_2.Selector)
+		assert.True(t, exists)
+		assert.Equal(t, GETH_DEVNET_2, v)
+		assert.Contains(t, ALL, GETH_DEVNET_2)
+	})
 }
 
 func Test_ChainByEvmChainID(t *testing.T) {
@@ -210,6 +226,12 @@ func Test_ChainByEvmChainID(t *testing.T) {
 		_, exists := ChainByEvmChainID(rand.Uint64())
 		assert.False(t, exists)
 	})
+
+	t.Run("test chain", func(t *testing.T) {
+		v, exists := ChainByEvmChainID(GETH_DEVNET_2.EvmChainID)
+		assert.True(t, exists)
+		assert.Equal(t, GETH_DEVNET_2, v)
+	})
 }
 
 func Test_IsEvm(t *testing.T) {
@@ -229,18 +251,17 @@ func Test_IsEvm(t *testing.T) {
 }
 
 func Test_EVMGetChainDetailsByChainIDAndFamily(t *testing.T) {
-	for k, v := range evmChainIdToChainSelector {
-		strChainID := strconv.FormatUint(k, 10)
-		details, err := GetChainDetailsByChainIDAndFamily(strChainID, FamilyEVM)
+	for _, e := range append(evmSelectors, evmTestSelectors...) {
+		details, err := GetChainDetailsByChainIDAndFamily(e.ChainID, FamilyEVM)
 		assert.NoError(t, err)
-		assert.Equal(t, v, details)
+		assert.Equal(t, e.ChainDetails, details)
 	}
 }
 
 func Test_EVMGetChainIDByChainSelector(t *testing.T) {
-	for k, v := range evmSelectorsMap {
-		chainID, err := GetChainIDFromSelector(v.ChainSelector)
+	for _, e := range evmSelectors {
+		chainID, err := GetChainIDFromSelector(e.ChainDetails.ChainSelector)
 		assert.NoError(t, err)
-		assert.Equal(t, chainID, fmt.Sprintf("%v", k))
+		assert.Equal(t, chainID, e.ChainID)
 	}
 }
@@ -1,8 +1,14 @@
 package chain_selectors
 
 import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
 	"log"
 	"os"
+	"strconv"
+	"strings"
 
 	"gopkg.in/yaml.v3"
 )
@@ -18,60 +24,188 @@ type ExtraSelectorsData struct {
 	Starknet map[string]ChainDetails `yaml:"starknet,omitempty"`
 	Canton   map[string]ChainDetails `yaml:"canton,omitempty"`
 	Stellar  map[string]ChainDetails `yaml:"stellar,omitempty"`
+	Cosmos   map[string]ChainDetails `yaml:"cosmos,omitempty"`
 }
 
+const (
+	// ExtraSelectorsModeStrict makes an invalid EXTRA_SELECTORS_FILE panic when the package is initialized.
+	// This is the default.
+	ExtraSelectorsModeStrict = "strict"
+	// ExtraSelectorsModeLenient logs an invalid EXTRA_SELECTORS_FILE, loads its valid entries
+	// and reports the problems through ExtraSelectorsLoadError.
+	ExtraSelectorsModeLenient = "lenient"
+)
+
 var (
 	extraSelectors       ExtraSelectorsData
 	extraSelectorsLoaded bool
+	extraSelectorsErr    error
 )
 
-func loadAndParseExtraSelectors() (result ExtraSelectorsData) {
-	extraSelectorsFile := os.Getenv("EXTRA_SELECTORS_FILE")
-	if extraSelectorsFile == "" {
-		return
+// ExtraSelectorError describes a single invalid entry of an extra selectors file.
+type ExtraSelectorError = ValidationError
+
+// ExtraSelectorsError lists every problem found in an extra selectors file.
+type ExtraSelectorsError struct {
+	// Source is the path of the file, empty when reading from an io.Reader
+	Source string
+	// Errors holds an *ExtraSelectorError per invalid entry, or the YAML errors
+	Errors []error
+}
+
+func (e *ExtraSelectorsError) Error() string {
+	messages := make([]string, 0, len(e.Errors))
+	for _, err := range e.Errors {
+		messages = append(messages, err.Error())
+	}
+	if e.Source == "" {
+		return fmt.Sprintf("invalid extra selectors: %s", strings.Join(messages, "; "))
 	}
+	return fmt.Sprintf("invalid extra selectors in %s: %s", e.Source, strings.Join(messages, "; "))
+}
+
+func (e *ExtraSelectorsError) Unwrap() []error {
+	return e.Errors
+}
 
-	fileContent, err := os.ReadFile(extraSelectorsFile)
+// ParseExtraSelectors reads and validates extra selectors in the EXTRA_SELECTORS_FILE format.
+// Invalid entries are left out of the returned data and reported in an *ExtraSelectorsError.
+func ParseExtraSelectors(reader io.Reader) (ExtraSelectorsData, error) {
+	content, err := io.ReadAll(reader)
 	if err != nil {
-		log.Printf("Error reading extra selectors file %s: %v", extraSelectorsFile, err)
-		panic(err)
+		return ExtraSelectorsData{}, fmt.Errorf("failed to read extra selectors: %w", err)
 	}
+	return parseExtraSelectors("", content)
+}
 
-	var data ExtraSelectorsData
-	err = yaml.Unmarshal(fileContent, &data)
+// ParseExtraSelectorsFile is like ParseExtraSelectors for the file at path.
+func ParseExtraSelectorsFile(path string) (ExtraSelectorsData, error) {
+	content, err := os.ReadFile(path)
 	if err != nil {
-		log.Printf("Error unmarshaling extra selectors YAML: %v", err)
-		panic(err)
+		return ExtraSelectorsData{}, fmt.Errorf("failed to read extra selectors file %s: %w", path, err)
 	}
+	return parseExtraSelectors(path, content)
+}
+
+// LoadExtraSelectors validates the extra selectors files at paths, merged as by ParseExtraSelectorsFiles,
+// and adds their chains to the default registry. Nothing is added if a file is invalid.
+// Existing chains are kept, as with ConflictPolicySkip.
+func LoadExtraSelectors(paths ...string) error {
+	return defaultRegistry.LoadExtraSelectors(paths...)
+}
+
+// LoadExtraSelectorsFromReader is like LoadExtraSelectors for extra selectors read from reader.
+func LoadExtraSelectorsFromReader(reader io.Reader) error {
+	return defaultRegistry.LoadExtraSelectorsFromReader(reader)
+}
 
-	// Validate individual chain formats
-	err = validateSolanaChainID(data.Solana)
+// LoadExtraSelectors validates the extra selectors files at paths, merged as by ParseExtraSelectorsFiles,
+// and adds their chains to the registry. Nothing is added if a file is invalid.
+// Existing chains are kept, as with ConflictPolicySkip.
+func (r *Registry) LoadExtraSelectors(paths ...string) error {
+	data, err := ParseExtraSelectorsFiles(paths...)
 	if err != nil {
-		log.Println(data.Solana)
-		log.Printf("Error parsing extra selectors for Solana: %v", err)
-		panic(err)
+		return err
 	}
+	_, err = r.MergeExtraSelectors(data, ConflictPolicySkip)
+	return err
+}
 
-	err = validateSuiChainID(data.Sui)
+// LoadExtraSelectorsFromReader is like Registry.LoadExtraSelectors for extra selectors read from reader.
+func (r *Registry) LoadExtraSelectorsFromReader(reader io.Reader) error {
+	data, err := ParseExtraSelectors(reader)
 	if err != nil {
-		log.Printf("Error parsing extra selectors for Sui: %v", err)
-		panic(err)
+		return err
 	}
-	err = validateAptosChainID(data.Aptos)
-	if err != nil {
-		log.Printf("Error parsing extra selectors for Aptos: %v", err)
-		panic(err)
+	_, err = r.MergeExtraSelectors(data, ConflictPolicySkip)
+	return err
+}
+
+// ExtraSelectorsLoadError returns the problems found in EXTRA_SELECTORS_FILE when the package was
+// initialized with EXTRA_SELECTORS_MODE=lenient, or nil if the file was valid or not set.
+func ExtraSelectorsLoadError() error {
+	return extraSelectorsErr
+}
+
+func parseExtraSelectors(source string, content []byte) (ExtraSelectorsData, error) {
+	var data ExtraSelectorsData
+	decoder := yaml.NewDecoder(bytes.NewReader(content))
+	var errs []error
+	if err := decoder.Decode(&data); err != nil && !errors.Is(err, io.EOF) {
+		var typeErr *yaml.TypeError
+		if !errors.As(err, &typeErr) {
+			return ExtraSelectorsData{}, &ExtraSelectorsError{Source: source, Errors: []error{err}}
+		}
+		// The decoder keeps going after type errors, so the remaining entries are still usable
+		for _, message := range typeErr.Errors {
+			errs = append(errs, errors.New(message))
+		}
+	}
+
+	errs = append(errs, validateExtraSelectors(&data)...)
+	if len(errs) > 0 {
+		return data, &ExtraSelectorsError{Source: source, Errors: errs}
+	}
+	return data, nil
+}
+
+// validateExtraSelectors removes the invalid chains from data and returns an *ExtraSelectorError for each of them.
+func validateExtraSelectors(data *ExtraSelectorsData) []error {
+	var errs []error
+	for _, err := range Validate(*data) {
+		err := err
+		errs = append(errs, &err)
+		data.Remove(err.Family, err.ChainID)
+	}
+	return errs
+}
+
+func formatString(id string) string {
+	return id
+}
+
+func formatUint(id uint64) string {
+	return strconv.FormatUint(id, 10)
+}
+
+func formatInt(id int32) string {
+	return strconv.FormatInt(int64(id), 10)
+}
+
+func loadAndParseExtraSelectors() (result ExtraSelectorsData) {
+	extraSelectorsFile := os.Getenv("EXTRA_SELECTORS_FILE")
+	if extraSelectorsFile == "" {
+		return
 	}
 
-	if err := validateCantonChainID(data.Canton); err != nil {
-		log.Printf("Error parsing extra selectors for Canton: %v", err)
-		panic(err)
+	data, err := ParseExtraSelectorsFiles(splitExtraSelectorsFiles(extraSelectorsFile)...)
+	if err != nil {
+		handleExtraSelectorsError(err)
+		return data
 	}
 
 	log.Printf("Successfully loaded extra selectors from %s", extraSelectorsFile)
 	return data
 }
 
+// handleExtraSelectorsError panics with err, or records it for ExtraSelectorsLoadError
+// if EXTRA_SELECTORS_MODE is lenient.
+func handleExtraSelectorsError(err error) {
+	mode := os.Getenv("EXTRA_SELECTORS_MODE")
+	if mode != "" && mode != ExtraSelectorsModeStrict && mode != ExtraSelectorsModeLenient {
+		log.Printf("WARN: Unknown EXTRA_SELECTORS_MODE %q, using %q", mode, ExtraSelectorsModeStrict)
+		mode = ExtraSelectorsModeStrict
+	}
+
+	if mode == ExtraSelectorsModeLenient {
+		log.Printf("WARN: Loading only the valid extra selectors: %v", err)
+		extraSelectorsErr = errors.Join(extraSelectorsErr, err)
+		return
+	}
+	log.Printf("Error loading extra selectors: %v", err)
+	panic(err)
+}
+
 func getExtraSelectors() ExtraSelectorsData {
 	if !extraSelectorsLoaded {
 		extraSelectors = loadAndParseExtraSelectors()
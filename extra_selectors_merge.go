@@ -0,0 +1,217 @@
+package chain_selectors
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"sort"
+	"strings"
+)
+
+// ConflictPolicy decides what happens to an extra selector whose chain ID already exists for its family
+// with different details.
+type ConflictPolicy int
+
+const (
+	// ConflictPolicySkip keeps the existing chain. This is the default.
+	ConflictPolicySkip ConflictPolicy = iota
+	// ConflictPolicyOverride replaces the existing chain with the extra selector.
+	ConflictPolicyOverride
+	// ConflictPolicyError rejects the extra selectors with a *ConflictError, adding none of them.
+	ConflictPolicyError
+)
+
+var conflictPolicyNames = map[ConflictPolicy]string{
+	ConflictPolicySkip:     "skip",
+	ConflictPolicyOverride: "override",
+	ConflictPolicyError:    "error",
+}
+
+func (p ConflictPolicy) String() string {
+	if name, exists := conflictPolicyNames[p]; exists {
+		return name
+	}
+	return fmt.Sprintf("ConflictPolicy(%d)", int(p))
+}
+
+// ParseConflictPolicy returns the policy named "skip", "override" or "error".
+func ParseConflictPolicy(name string) (ConflictPolicy, error) {
+	for policy, policyName := range conflictPolicyNames {
+		if policyName == name {
+			return policy, nil
+		}
+	}
+	return ConflictPolicySkip, fmt.Errorf("unknown conflict policy %q, must be one of skip, override or error", name)
+}
+
+// SelectorConflict is an extra selector that conflicts with a chain in the registry, or with
+// another extra selector using the same selector.
+type SelectorConflict struct {
+	Family  string
+	ChainID string
+	Details ChainDetails
+
+	ExistingFamily  string
+	ExistingChainID string
+	Existing        ChainDetails
+}
+
+// SelectorReused reports whether the conflict is a selector used by a different chain,
+// rather than a chain ID that already exists.
+func (c SelectorConflict) SelectorReused() bool {
+	return c.Family != c.ExistingFamily || c.ChainID != c.ExistingChainID
+}
+
+func (c SelectorConflict) String() string {
+	if c.SelectorReused() {
+		return fmt.Sprintf("selector %d of %s chain %s is already used by %s chain %s",
+			c.Details.ChainSelector, c.Family, c.ChainID, c.ExistingFamily, c.ExistingChainID)
+	}
+	return fmt.Sprintf("%s chain %s already exists", c.Family, c.ChainID)
+}
+
+// MergeReport lists what happened to the extra selectors merged into a registry.
+type MergeReport struct {
+	// Added is the number of chains added
+	Added int
+	// Skipped chains already existed and were kept, see ConflictPolicySkip
+	Skipped []SelectorConflict
+	// Overridden chains already existed and were replaced, see ConflictPolicyOverride
+	Overridden []SelectorConflict
+	// Rejected extra selectors reuse the selector of another chain, or conflict with an
+	// existing chain under ConflictPolicyError. They are never added.
+	Rejected []SelectorConflict
+}
+
+// ConflictError is returned by MergeExtraSelectors with ConflictPolicyError.
+type ConflictError struct {
+	Conflicts []SelectorConflict
+}
+
+func (e *ConflictError) Error() string {
+	descriptions := make([]string, 0, len(e.Conflicts))
+	for _, c := range e.Conflicts {
+		descriptions = append(descriptions, c.String())
+	}
+	return fmt.Sprintf("extra selectors conflict with existing chains: %s", strings.Join(descriptions, "; "))
+}
+
+var extraSelectorsReport MergeReport
+
+// ExtraSelectorsReport returns what happened to the chains of EXTRA_SELECTORS_FILE when they were
+// merged into the default registry, using the policy set by EXTRA_SELECTORS_CONFLICT_POLICY.
+func ExtraSelectorsReport() MergeReport {
+	return extraSelectorsReport
+}
+
+// MergeExtraSelectors adds the chains in data to the default registry, see Registry.MergeExtraSelectors.
+func MergeExtraSelectors(data ExtraSelectorsData, policy ConflictPolicy) (MergeReport, error) {
+	return defaultRegistry.MergeExtraSelectors(data, policy)
+}
+
+// MergeExtraSelectors adds the chains in data to the registry. Chains whose chain ID already exists
+// with different details are handled according to policy. Chains reusing the selector of another chain,
+// of any family, are always rejected. With ConflictPolicyError nothing is added if there is any conflict.
+func (r *Registry) MergeExtraSelectors(data ExtraSelectorsData, policy ConflictPolicy) (MergeReport, error) {
+	var entries []chainEntry
+	data.ForEach(func(family, chainID string, details ChainDetails) {
+		entries = append(entries, chainEntry{Family: family, ChainID: chainID, ChainDetails: details})
+	})
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].Family != entries[j].Family {
+			return entries[i].Family < entries[j].Family
+		}
+		return entries[i].ChainID < entries[j].ChainID
+	})
+
+	var report MergeReport
+	err := r.update(func(s *registrySnapshot) error {
+		var (
+			additions []chainEntry
+			removals  []chainEntry
+			// selectors taken by the extra selectors added so far
+			pending = make(map[uint64]chainEntry)
+		)
+		for _, e := range entries {
+			selector := e.ChainDetails.ChainSelector
+			other, exists := pending[selector]
+			if !exists {
+				other, exists = s.bySelector[selector]
+			}
+			if exists && (other.Family != e.Family || other.ChainID != e.ChainID) {
+				report.Rejected = append(report.Rejected, newSelectorConflict(e, other))
+				continue
+			}
+
+			existing, exists := s.entryByChainID(e.Family, e.ChainID)
+			if !exists {
+				additions = append(additions, e)
+				pending[selector] = e
+				continue
+			}
+			if existing.ChainDetails == e.ChainDetails {
+				continue
+			}
+
+			conflict := newSelectorConflict(e, existing)
+			switch policy {
+			case ConflictPolicyOverride:
+				report.Overridden = append(report.Overridden, conflict)
+				removals = append(removals, existing)
+				additions = append(additions, e)
+				pending[selector] = e
+			case ConflictPolicyError:
+				report.Rejected = append(report.Rejected, conflict)
+			default:
+				report.Skipped = append(report.Skipped, conflict)
+			}
+		}
+
+		for _, c := range report.Rejected {
+			log.Printf("WARN: Rejecting extra selector: %s", c)
+		}
+		if policy == ConflictPolicyError && len(report.Rejected) > 0 {
+			return &ConflictError{Conflicts: report.Rejected}
+		}
+
+		for _, c := range report.Skipped {
+			log.Printf("WARN: Skipping extra selector for %s chain %s because it already exists", c.Family, c.ChainID)
+		}
+		for _, c := range report.Overridden {
+			log.Printf("WARN: Overriding %s chain %s with extra selector", c.Family, c.ChainID)
+		}
+		for _, e := range removals {
+			s.remove(e)
+		}
+		for _, e := range additions {
+			s.add(e)
+		}
+		report.Added = len(additions) - len(report.Overridden)
+		return nil
+	})
+	return report, err
+}
+
+func newSelectorConflict(e, existing chainEntry) SelectorConflict {
+	return SelectorConflict{
+		Family:          e.Family,
+		ChainID:         e.ChainID,
+		Details:         e.ChainDetails,
+		ExistingFamily:  existing.Family,
+		ExistingChainID: existing.ChainID,
+		Existing:        existing.ChainDetails,
+	}
+}
+
+// extraSelectorsConflictPolicy returns the policy set by EXTRA_SELECTORS_CONFLICT_POLICY.
+func extraSelectorsConflictPolicy() ConflictPolicy {
+	name := os.Getenv("EXTRA_SELECTORS_CONFLICT_POLICY")
+	if name == "" {
+		return ConflictPolicySkip
+	}
+	policy, err := ParseConflictPolicy(name)
+	if err != nil {
+		log.Printf("WARN: %v, using %q", err, ConflictPolicySkip)
+	}
+	return policy
+}
@@ -0,0 +1,120 @@
+package chain_selectors
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// conflictingExtraSelectors redefines hyperliquid-mainnet (evm 999), reuses the selector of
+// ethereum-mainnet for a Solana chain and adds a new chain.
+var conflictingExtraSelectors = ExtraSelectorsData{
+	Evm: map[uint64]ChainDetails{
+		999:         {ChainSelector: 9876543210987654321, ChainName: "overriding-existing-chain", NetworkType: NetworkTypeTestnet},
+		90909090111: {ChainSelector: 1234567890123456789, ChainName: "test-evm-chain", NetworkType: NetworkTypeTestnet},
+	},
+	Solana: map[string]ChainDetails{
+		"ASwXBTzJM5evpfrWSHSjZaxPErZRuiGJnFixGUHi4NQT": {ChainSelector: 5009297550715157269, ChainName: "test-solana-chain"},
+	},
+}
+
+func TestMergeExtraSelectorsSkip(t *testing.T) {
+	r := NewEmbeddedRegistry()
+	report, err := r.MergeExtraSelectors(conflictingExtraSelectors, ConflictPolicySkip)
+	require.NoError(t, err)
+
+	assert.Equal(t, 1, report.Added)
+	require.Len(t, report.Skipped, 1)
+	assert.Equal(t, "999", report.Skipped[0].ChainID)
+	assert.Equal(t, "hyperliquid-mainnet", report.Skipped[0].Existing.ChainName)
+	assert.Empty(t, report.Overridden)
+
+	require.Len(t, report.Rejected, 1)
+	rejected := report.Rejected[0]
+	assert.True(t, rejected.SelectorReused())
+	assert.Equal(t, FamilySolana, rejected.Family)
+	assert.Equal(t, FamilyEVM, rejected.ExistingFamily)
+	assert.Equal(t, "1", rejected.ExistingChainID)
+
+	name, err := r.GetChainNameFromSelector(2442541497099098535)
+	require.NoError(t, err)
+	assert.Equal(t, "hyperliquid-mainnet", name)
+	family, err := r.GetSelectorFamily(5009297550715157269)
+	require.NoError(t, err)
+	assert.Equal(t, FamilyEVM, family, "selectors are unique across families")
+	_, err = r.GetChainDetails(1234567890123456789)
+	assert.NoError(t, err)
+}
+
+func TestMergeExtraSelectorsOverride(t *testing.T) {
+	r := NewEmbeddedRegistry()
+	report, err := r.MergeExtraSelectors(conflictingExtraSelectors, ConflictPolicyOverride)
+	require.NoError(t, err)
+
+	assert.Equal(t, 1, report.Added)
+	require.Len(t, report.Overridden, 1)
+	assert.Equal(t, "999", report.Overridden[0].ChainID)
+	assert.Len(t, report.Rejected, 1)
+
+	details, err := r.GetChainDetailsByChainIDAndFamily("999", FamilyEVM)
+	require.NoError(t, err)
+	assert.Equal(t, "overriding-existing-chain", details.ChainName)
+	_, err = r.GetChainDetails(2442541497099098535)
+	assert.ErrorIs(t, err, ErrUnknownSelector, "the previous selector is removed")
+	_, err = r.GetChainDetailsByNetworkName("hyperliquid-mainnet")
+	assert.ErrorIs(t, err, ErrUnknownChainName)
+	_, err = r.GetChainDetailsByNetworkName("overriding-existing-chain")
+	assert.NoError(t, err)
+}
+
+func TestMergeExtraSelectorsError(t *testing.T) {
+	r := NewEmbeddedRegistry()
+	report, err := r.MergeExtraSelectors(conflictingExtraSelectors, ConflictPolicyError)
+
+	var conflictErr *ConflictError
+	require.ErrorAs(t, err, &conflictErr)
+	assert.Len(t, conflictErr.Conflicts, 2)
+	assert.Equal(t, report.Rejected, conflictErr.Conflicts)
+	assert.Zero(t, report.Added)
+
+	_, err = r.GetChainDetails(1234567890123456789)
+	assert.Error(t, err, "nothing is added")
+}
+
+func TestMergeExtraSelectorsWithinData(t *testing.T) {
+	r := NewRegistry()
+	report, err := r.MergeExtraSelectors(ExtraSelectorsData{
+		Evm:      map[uint64]ChainDetails{1337: {ChainSelector: 1111111111111111111, ChainName: "test-evm-chain"}},
+		Starknet: map[string]ChainDetails{"TEST_SN": {ChainSelector: 1111111111111111111, ChainName: "test-starknet-chain"}},
+	}, ConflictPolicySkip)
+	require.NoError(t, err)
+
+	assert.Equal(t, 1, report.Added)
+	require.Len(t, report.Rejected, 1)
+	assert.Equal(t, FamilyStarknet, report.Rejected[0].Family)
+	assert.Equal(t, "selector 1111111111111111111 of starknet chain TEST_SN is already used by evm chain 1337", report.Rejected[0].String())
+
+	// Merging the same data again changes nothing
+	report, err = r.MergeExtraSelectors(ExtraSelectorsData{
+		Evm: map[uint64]ChainDetails{1337: {ChainSelector: 1111111111111111111, ChainName: "test-evm-chain"}},
+	}, ConflictPolicyError)
+	require.NoError(t, err)
+	assert.Equal(t, MergeReport{}, report)
+}
+
+func TestParseConflictPolicy(t *testing.T) {
+	for _, policy := range []ConflictPolicy{ConflictPolicySkip, ConflictPolicyOverride, ConflictPolicyError} {
+		parsed, err := ParseConflictPolicy(policy.String())
+		require.NoError(t, err)
+		assert.Equal(t, policy, parsed)
+	}
+
+	_, err := ParseConflictPolicy("replace")
+	assert.Error(t, err)
+
+	t.Setenv("EXTRA_SELECTORS_CONFLICT_POLICY", "override")
+	assert.Equal(t, ConflictPolicyOverride, extraSelectorsConflictPolicy())
+	t.Setenv("EXTRA_SELECTORS_CONFLICT_POLICY", "replace")
+	assert.Equal(t, ConflictPolicySkip, extraSelectorsConflictPolicy())
+}
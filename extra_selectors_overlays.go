@@ -0,0 +1,259 @@
+package chain_selectors
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// ExtraSelectorConflictError is returned when two extra selectors files define the same chain differently.
+// The entry read first is kept.
+type ExtraSelectorConflictError struct {
+	Family  string
+	ChainID string
+	// File and Line locate the ignored entry
+	File string
+	Line int
+	// ExistingFile and ExistingLine locate the entry that was kept
+	ExistingFile string
+	ExistingLine int
+}
+
+func (e *ExtraSelectorConflictError) Error() string {
+	return fmt.Sprintf("%s chain %s at %s:%d conflicts with %s:%d",
+		e.Family, e.ChainID, e.File, e.Line, e.ExistingFile, e.ExistingLine)
+}
+
+// ParseExtraSelectorsFiles reads, validates and merges extra selectors files in order.
+// A directory stands for the *.yml and *.yaml files it contains, in lexical order.
+// Invalid entries and chains defined differently by several files are left out of the
+// returned data and reported in the returned error.
+func ParseExtraSelectorsFiles(paths ...string) (ExtraSelectorsData, error) {
+	files, err := expandExtraSelectorsPaths(paths)
+	if err != nil {
+		return ExtraSelectorsData{}, err
+	}
+
+	var (
+		merged  ExtraSelectorsData
+		errs    []error
+		origins = make(map[string]map[string]extraSelectorOrigin) // family -> chain ID -> origin
+	)
+	for _, file := range files {
+		content, err := os.ReadFile(file)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to read extra selectors file %s: %w", file, err))
+			continue
+		}
+		data, err := parseExtraSelectors(file, content)
+		if err != nil {
+			errs = append(errs, err)
+		}
+		errs = append(errs, mergeExtraSelectors(&merged, data, file, extraSelectorLines(content), origins)...)
+	}
+
+	switch len(errs) {
+	case 0:
+		return merged, nil
+	case 1:
+		return merged, errs[0]
+	default:
+		return merged, errors.Join(errs...)
+	}
+}
+
+type extraSelectorOrigin struct {
+	file    string
+	line    int
+	details ChainDetails
+}
+
+// mergeExtraSelectors adds the chains in data read from file to merged. Chains already defined
+// differently by a previous file are skipped and returned as *ExtraSelectorConflictError.
+func mergeExtraSelectors(merged *ExtraSelectorsData, data ExtraSelectorsData, file string, lines map[string]map[string]int, origins map[string]map[string]extraSelectorOrigin) []error {
+	var conflicts []error
+	data.ForEach(func(family, chainID string, details ChainDetails) {
+		line := lines[family][chainID]
+		if existing, exists := origins[family][chainID]; exists {
+			if existing.details != details {
+				conflicts = append(conflicts, &ExtraSelectorConflictError{
+					Family:       family,
+					ChainID:      chainID,
+					File:         file,
+					Line:         line,
+					ExistingFile: existing.file,
+					ExistingLine: existing.line,
+				})
+			}
+			return
+		}
+
+		if _, exists := origins[family]; !exists {
+			origins[family] = make(map[string]extraSelectorOrigin)
+		}
+		origins[family][chainID] = extraSelectorOrigin{file: file, line: line, details: details}
+		merged.Set(family, chainID, details)
+	})
+
+	sort.Slice(conflicts, func(i, j int) bool {
+		return conflicts[i].(*ExtraSelectorConflictError).Line < conflicts[j].(*ExtraSelectorConflictError).Line
+	})
+	return conflicts
+}
+
+// expandExtraSelectorsPaths replaces directories in paths with the YAML files they contain.
+func expandExtraSelectorsPaths(paths []string) ([]string, error) {
+	var files []string
+	for _, path := range paths {
+		if path == "" {
+			continue
+		}
+		info, err := os.Stat(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read extra selectors file %s: %w", path, err)
+		}
+		if !info.IsDir() {
+			files = append(files, path)
+			continue
+		}
+
+		entries, err := os.ReadDir(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read extra selectors directory %s: %w", path, err)
+		}
+		// ReadDir returns the entries sorted by name
+		for _, entry := range entries {
+			ext := filepath.Ext(entry.Name())
+			if entry.IsDir() || (ext != ".yml" && ext != ".yaml") {
+				continue
+			}
+			files = append(files, filepath.Join(path, entry.Name()))
+		}
+	}
+	return files, nil
+}
+
+// extraSelectorLines returns the line of every chain in an extra selectors file, by family and chain ID.
+// Chain IDs are formatted the same way as in the registry.
+func extraSelectorLines(content []byte) map[string]map[string]int {
+	lines := make(map[string]map[string]int)
+	var root yaml.Node
+	if err := yaml.Unmarshal(content, &root); err != nil || len(root.Content) == 0 {
+		return lines
+	}
+
+	families := root.Content[0]
+	if families.Kind != yaml.MappingNode {
+		return lines
+	}
+	for i := 0; i+1 < len(families.Content); i += 2 {
+		family, chains := families.Content[i].Value, families.Content[i+1]
+		if chains.Kind != yaml.MappingNode {
+			continue
+		}
+		lines[family] = make(map[string]int)
+		for j := 0; j+1 < len(chains.Content); j += 2 {
+			key := chains.Content[j]
+			lines[family][normalizeYamlChainID(key.Value)] = key.Line
+		}
+	}
+	return lines
+}
+
+func normalizeYamlChainID(chainID string) string {
+	if id, err := strconv.ParseInt(chainID, 10, 64); err == nil {
+		return strconv.FormatInt(id, 10)
+	}
+	if id, err := strconv.ParseUint(chainID, 10, 64); err == nil {
+		return strconv.FormatUint(id, 10)
+	}
+	return chainID
+}
+
+// Set adds the chain to the map of its family, parsing chainID, formatted as by ForEach, for families with
+// numeric chain IDs.
+func (data *ExtraSelectorsData) Set(family, chainID string, details ChainDetails) {
+	switch family {
+	case FamilyEVM:
+		id, _ := strconv.ParseUint(chainID, 10, 64)
+		data.Evm = setExtraEntry(data.Evm, id, details)
+	case FamilySolana:
+		data.Solana = setExtraEntry(data.Solana, chainID, details)
+	case FamilyAptos:
+		id, _ := strconv.ParseUint(chainID, 10, 64)
+		data.Aptos = setExtraEntry(data.Aptos, id, details)
+	case FamilySui:
+		id, _ := strconv.ParseUint(chainID, 10, 64)
+		data.Sui = setExtraEntry(data.Sui, id, details)
+	case FamilyTron:
+		id, _ := strconv.ParseUint(chainID, 10, 64)
+		data.Tron = setExtraEntry(data.Tron, id, details)
+	case FamilyTon:
+		id, _ := strconv.ParseInt(chainID, 10, 32)
+		data.Ton = setExtraEntry(data.Ton, int32(id), details)
+	case FamilyStarknet:
+		data.Starknet = setExtraEntry(data.Starknet, chainID, details)
+	case FamilyCanton:
+		data.Canton = setExtraEntry(data.Canton, chainID, details)
+	case FamilyStellar:
+		data.Stellar = setExtraEntry(data.Stellar, chainID, details)
+	case FamilyCosmos:
+		data.Cosmos = setExtraEntry(data.Cosmos, chainID, details)
+	}
+}
+
+// Remove deletes the chain with the given family and chain ID, formatted as by ForEach, from the map of its family.
+func (data *ExtraSelectorsData) Remove(family, chainID string) {
+	switch family {
+	case FamilyEVM:
+		id, _ := strconv.ParseUint(chainID, 10, 64)
+		delete(data.Evm, id)
+	case FamilySolana:
+		delete(data.Solana, chainID)
+	case FamilyAptos:
+		id, _ := strconv.ParseUint(chainID, 10, 64)
+		delete(data.Aptos, id)
+	case FamilySui:
+		id, _ := strconv.ParseUint(chainID, 10, 64)
+		delete(data.Sui, id)
+	case FamilyTron:
+		id, _ := strconv.ParseUint(chainID, 10, 64)
+		delete(data.Tron, id)
+	case FamilyTon:
+		id, _ := strconv.ParseInt(chainID, 10, 32)
+		delete(data.Ton, int32(id))
+	case FamilyStarknet:
+		delete(data.Starknet, chainID)
+	case FamilyCanton:
+		delete(data.Canton, chainID)
+	case FamilyStellar:
+		delete(data.Stellar, chainID)
+	case FamilyCosmos:
+		delete(data.Cosmos, chainID)
+	}
+}
+
+func setExtraEntry[K comparable](entries map[K]ChainDetails, chainID K, details ChainDetails) map[K]ChainDetails {
+	if entries == nil {
+		entries = make(map[K]ChainDetails)
+	}
+	entries[chainID] = details
+	return entries
+}
+
+// splitExtraSelectorsFiles splits the EXTRA_SELECTORS_FILE value into its paths.
+func splitExtraSelectorsFiles(value string) []string {
+	var paths []string
+	for _, path := range filepath.SplitList(value) {
+		if path = strings.TrimSpace(path); path != "" {
+			paths = append(paths, path)
+		}
+	}
+	return paths
+}
@@ -0,0 +1,163 @@
+package chain_selectors
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeOverlay(t *testing.T, dir, name, content string) string {
+	path := filepath.Join(dir, name)
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	return path
+}
+
+func TestParseExtraSelectorsFilesDirectory(t *testing.T) {
+	dir := t.TempDir()
+	writeOverlay(t, dir, "b-team.yml", `
+evm:
+  90909090111:
+    selector: 1234567890123456789
+    name: "test-evm-chain"
+`)
+	writeOverlay(t, dir, "a-team.yaml", `
+evm:
+  90909090222:
+    selector: 1234567890123456780
+    name: "test-evm-chain-2"
+cosmos:
+  "test-cosmos-1":
+    selector: 4444444444444444444
+    name: "test-cosmos-chain"
+`)
+	writeOverlay(t, dir, "README.md", "not: [yaml")
+	require.NoError(t, os.Mkdir(filepath.Join(dir, "nested.yml"), 0o700))
+
+	data, err := ParseExtraSelectorsFiles(dir)
+	require.NoError(t, err)
+	assert.Len(t, data.Evm, 2)
+	assert.Len(t, data.Cosmos, 1)
+	assert.Equal(t, "test-evm-chain", data.Evm[90909090111].ChainName)
+}
+
+func TestParseExtraSelectorsFilesConflicts(t *testing.T) {
+	dir := t.TempDir()
+	first := writeOverlay(t, dir, "first.yml", `
+evm:
+  90909090111:
+    selector: 1234567890123456789
+    name: "test-evm-chain"
+ton:
+  -666:
+    selector: 3333333333333333333
+    name: "test-ton-chain"
+`)
+	second := writeOverlay(t, dir, "second.yml", `
+ton:
+  -666:
+    selector: 3333333333333333333
+    name: "test-ton-chain"
+evm:
+  90909090111:
+    selector: 1234567890123456789
+    name: "renamed-evm-chain"
+  90909090222:
+    selector: 1234567890123456780
+    name: "test-evm-chain-2"
+`)
+
+	data, err := ParseExtraSelectorsFiles(first, second)
+	require.Error(t, err)
+
+	var conflict *ExtraSelectorConflictError
+	require.ErrorAs(t, err, &conflict)
+	assert.Equal(t, ExtraSelectorConflictError{
+		Family:       FamilyEVM,
+		ChainID:      "90909090111",
+		File:         second,
+		Line:         7,
+		ExistingFile: first,
+		ExistingLine: 3,
+	}, *conflict)
+	assert.Equal(t, "evm chain 90909090111 at "+second+":7 conflicts with "+first+":3", conflict.Error())
+
+	// The first definition wins and identical definitions are not conflicts
+	assert.Equal(t, "test-evm-chain", data.Evm[90909090111].ChainName)
+	assert.Len(t, data.Evm, 2)
+	assert.Len(t, data.Ton, 1)
+	assert.NotContains(t, err.Error(), "ton")
+}
+
+func TestParseExtraSelectorsFilesErrors(t *testing.T) {
+	dir := t.TempDir()
+	valid := writeOverlay(t, dir, "valid.yml", yamlSingleFamily)
+	invalid := writeOverlay(t, dir, "invalid.yml", `
+aptos:
+  0:
+    selector: 9876543210987654321
+`)
+
+	data, err := ParseExtraSelectorsFiles(valid, invalid)
+	var extraErr *ExtraSelectorsError
+	require.ErrorAs(t, err, &extraErr)
+	assert.Equal(t, invalid, extraErr.Source)
+	assert.Len(t, data.Evm, 1, "valid files are still merged")
+
+	_, err = ParseExtraSelectorsFiles(valid, filepath.Join(dir, "missing.yml"))
+	assert.ErrorIs(t, err, os.ErrNotExist)
+}
+
+func TestExtraSelectorsFileList(t *testing.T) {
+	dir := t.TempDir()
+	overlays := filepath.Join(dir, "overlays")
+	require.NoError(t, os.Mkdir(overlays, 0o700))
+	writeOverlay(t, overlays, "devnet.yml", `
+sui:
+  777:
+    selector: 2222222222222222222
+    name: "test-sui-chain"
+`)
+	single := writeOverlay(t, dir, "single.yml", yamlSingleFamily)
+
+	cleanup := setSelectorEnv(t, strings.Join([]string{single, "", overlays}, string(os.PathListSeparator)))
+	defer cleanup()
+
+	result := loadAndParseExtraSelectors()
+	assert.Len(t, result.Evm, 1)
+	assert.Len(t, result.Sui, 1)
+}
+
+func TestExtraSelectorsDataForEachAndRemove(t *testing.T) {
+	data := ExtraSelectorsData{
+		Evm:      map[uint64]ChainDetails{1: {ChainSelector: 1}},
+		Aptos:    map[uint64]ChainDetails{2: {ChainSelector: 2}},
+		Solana:   map[string]ChainDetails{"3": {ChainSelector: 3}},
+		Sui:      map[uint64]ChainDetails{4: {ChainSelector: 4}},
+		Ton:      map[int32]ChainDetails{-5: {ChainSelector: 5}},
+		Tron:     map[uint64]ChainDetails{6: {ChainSelector: 6}},
+		Starknet: map[string]ChainDetails{"7": {ChainSelector: 7}},
+		Canton:   map[string]ChainDetails{"8": {ChainSelector: 8}},
+		Stellar:  map[string]ChainDetails{"9": {ChainSelector: 9}},
+		Cosmos:   map[string]ChainDetails{"10": {ChainSelector: 10}},
+	}
+
+	// Every family of ExtraSelectorsData must be visited
+	visited := make(map[string]string)
+	data.ForEach(func(family, chainID string, details ChainDetails) {
+		visited[family] = chainID
+	})
+	assert.Len(t, visited, reflect.TypeOf(data).NumField())
+	assert.Equal(t, "-5", visited[FamilyTon])
+
+	for family, chainID := range visited {
+		data.Remove(family, chainID)
+	}
+	data.ForEach(func(family, chainID string, details ChainDetails) {
+		t.Errorf("%s chain %s was not removed", family, chainID)
+	})
+}
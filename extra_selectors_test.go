@@ -3,6 +3,7 @@ package chain_selectors
 import (
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -80,6 +81,10 @@ starknet:
   "TEST_SN":
     selector: 1111111111111111111
     name: "test-starknet-chain"
+cosmos:
+  "test-cosmos-1":
+    selector: 4444444444444444444
+    name: "test-cosmos-chain"
 `
 )
 
@@ -107,6 +112,7 @@ func TestExtraSelectors(t *testing.T) {
 		assert.Len(t, result.Ton, 1)
 		assert.Len(t, result.Tron, 1)
 		assert.Len(t, result.Starknet, 1)
+		assert.Len(t, result.Cosmos, 1)
 
 		aptosChain, exists := result.Aptos[888]
 		assert.True(t, exists)
@@ -261,3 +267,126 @@ func TestExtraSelectorsValidateCustomFile(t *testing.T) {
 		loadAndParseExtraSelectors()
 	}, "Loading extra selectors file should not panic if file is valid")
 }
+
+func TestParseExtraSelectors(t *testing.T) {
+	t.Run("reports every invalid entry", func(t *testing.T) {
+		data, err := ParseExtraSelectors(strings.NewReader(`
+solana:
+  "not-base58-0OIl":
+    selector: 1111111111111111111
+  "ASwXBTzJM5evpfrWSHSjZaxPErZRuiGJnFixGUHi4NQT":
+    selector: 2222222222222222222
+    name: "test-solana-chain"
+  "abc":
+    selector: 3333333333333333333
+cosmos:
+  "has space":
+    selector: 4444444444444444444
+`))
+		require.Error(t, err)
+
+		var extraErr *ExtraSelectorsError
+		require.ErrorAs(t, err, &extraErr)
+		require.Len(t, extraErr.Errors, 3)
+		assert.ErrorIs(t, err, ErrInvalidChainID)
+
+		var entryErr *ExtraSelectorError
+		require.ErrorAs(t, extraErr.Errors[0], &entryErr)
+		assert.Equal(t, FamilySolana, entryErr.Family)
+		assert.Equal(t, "abc", entryErr.ChainID)
+		require.ErrorAs(t, extraErr.Errors[2], &entryErr)
+		assert.Equal(t, FamilyCosmos, entryErr.Family)
+
+		// Valid entries are kept
+		assert.Len(t, data.Solana, 1)
+		assert.Contains(t, data.Solana, "ASwXBTzJM5evpfrWSHSjZaxPErZRuiGJnFixGUHi4NQT")
+		assert.Empty(t, data.Cosmos)
+	})
+
+	t.Run("reports type errors with their line", func(t *testing.T) {
+		data, err := ParseExtraSelectors(strings.NewReader(`
+evm:
+  "abc":
+    selector: 1234567890123456789
+  90909090111:
+    selector: 1234567890123456780
+`))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "line 3")
+		assert.Len(t, data.Evm, 1)
+	})
+
+	t.Run("reports syntax errors", func(t *testing.T) {
+		_, err := ParseExtraSelectors(strings.NewReader("invalid: yaml: syntax: [unclosed"))
+		var extraErr *ExtraSelectorsError
+		require.ErrorAs(t, err, &extraErr)
+		assert.Empty(t, extraErr.Source)
+	})
+
+	t.Run("reports missing files", func(t *testing.T) {
+		_, err := ParseExtraSelectorsFile("/non/existent/file.yaml")
+		assert.ErrorIs(t, err, os.ErrNotExist)
+	})
+}
+
+func TestRegistryLoadExtraSelectors(t *testing.T) {
+	r := NewEmbeddedRegistry()
+
+	invalid := createTempYamlFile(t, `
+evm:
+  90909090111:
+    selector: 1234567890123456789
+    name: "test-evm-chain"
+aptos:
+  0:
+    selector: 9876543210987654321
+    name: "test-aptos-chain"
+    network_type: testnet
+`)
+	defer os.Remove(invalid)
+
+	err := r.LoadExtraSelectors(invalid)
+	var extraErr *ExtraSelectorsError
+	require.ErrorAs(t, err, &extraErr)
+	assert.Equal(t, invalid, extraErr.Source)
+	_, err = r.GetChainDetails(1234567890123456789)
+	assert.Error(t, err, "nothing is added from an invalid file")
+
+	require.NoError(t, r.LoadExtraSelectorsFromReader(strings.NewReader(yamlSingleFamily)))
+	details, err := r.GetChainDetailsByChainIDAndFamily("999", FamilyEVM)
+	require.NoError(t, err)
+	assert.Equal(t, "hyperliquid-mainnet", details.ChainName, "existing chains are not overridden")
+
+	require.NoError(t, r.LoadExtraSelectorsFromReader(strings.NewReader(yamlMultipleFamilies)))
+	details, err = r.GetChainDetailsByChainIDAndFamily("888", FamilyAptos)
+	require.NoError(t, err)
+	assert.Equal(t, "test-aptos-chain", details.ChainName)
+}
+
+func TestExtraSelectorsLenientMode(t *testing.T) {
+	filePath := createTempYamlFile(t, `
+evm:
+  "abc":
+    selector: 1234567890123456789
+  90909090111:
+    selector: 1234567890123456780
+`)
+	defer os.Remove(filePath)
+
+	cleanup := setSelectorEnv(t, filePath)
+	defer cleanup()
+	t.Setenv("EXTRA_SELECTORS_MODE", ExtraSelectorsModeLenient)
+	t.Cleanup(func() { extraSelectorsErr = nil })
+
+	var result ExtraSelectorsData
+	require.NotPanics(t, func() {
+		result = loadAndParseExtraSelectors()
+	})
+	assert.Len(t, result.Evm, 1)
+	assert.Error(t, ExtraSelectorsLoadError())
+
+	t.Setenv("EXTRA_SELECTORS_MODE", ExtraSelectorsModeStrict)
+	assert.Panics(t, func() {
+		loadAndParseExtraSelectors()
+	})
+}
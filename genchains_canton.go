@@ -24,6 +24,7 @@ type chain struct {
 	Name        string
 	VarName     string
 	NetworkType string
+	Deprecated  bool
 }
 
 var chainTemplate, _ = template.New("").Parse(`// Code generated by go generate please DO NOT EDIT
@@ -34,11 +35,12 @@ type CantonChain struct {
 	Selector    uint64
 	Name        string
 	NetworkType NetworkType
+	Deprecated  bool
 }
 
 var (
 	{{- range . }}
-	{{.VarName}} = CantonChain{ChainID: "{{ .ChainID }}",Selector: {{ .Selector }}, Name: "{{ .Name }}", NetworkType: {{ .NetworkType }}}
+	{{.VarName}} = CantonChain{ChainID: "{{ .ChainID }}",Selector: {{ .Selector }}, Name: "{{ .Name }}", NetworkType: {{ .NetworkType }}{{ if .Deprecated }}, Deprecated: true{{ end }}}
 	{{- end }}
 )
 
@@ -91,12 +93,18 @@ func genChainsSourceCode() (string, error) {
 			return "", err
 		}
 
+		deprecated, err := chain_selectors.IsDeprecated(chainSel)
+		if err != nil {
+			return "", err
+		}
+
 		chains = append(chains, chain{
 			ChainID:     chainID,
 			Selector:    chainSel,
 			Name:        name,
 			VarName:     toVarName(name, chainSel),
 			NetworkType: fmt.Sprintf("NetworkType%s", strings.Title(string(networkType))),
+			Deprecated:  deprecated,
 		})
 	}
 
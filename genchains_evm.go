@@ -24,6 +24,7 @@ type chain struct {
 	Name        string
 	VarName     string
 	NetworkType string
+	Deprecated  bool
 }
 
 var chainTemplate, _ = template.New("").Parse(`// Code generated by go generate please DO NOT EDIT
@@ -34,12 +35,13 @@ type Chain struct {
 	Selector    uint64
 	Name        string
 	NetworkType NetworkType
+	Deprecated  bool
 	VarName     string
 }
 
 var (
 {{ range . }}
-	{{.VarName}} = Chain{EvmChainID: {{ .EvmChainID }}, Selector: {{ .Selector }}, Name: "{{ .Name }}", NetworkType: {{ .NetworkType }}}{{ end }}
+	{{.VarName}} = Chain{EvmChainID: {{ .EvmChainID }}, Selector: {{ .Selector }}, Name: "{{ .Name }}", NetworkType: {{ .NetworkType }}{{ if .Deprecated }}, Deprecated: true{{ end }}}{{ end }}
 )
 
 var ALL = []Chain{
@@ -91,11 +93,17 @@ func genChainsSourceCode() (string, error) {
 		if err != nil {
 			return "", err
 		}
+		deprecated, err := chain_selectors.IsDeprecated(chainSel)
+		if err != nil {
+			return "", err
+		}
+
 		chains = append(chains, chain{
 			EvmChainID:  evmChainID,
 			Selector:    chainSel,
 			Name:        name,
 			NetworkType: fmt.Sprintf("NetworkType%s", strings.Title(string(networkType))),
+			Deprecated:  deprecated,
 			VarName:     toVarName(name, chainSel),
 		})
 	}
@@ -24,6 +24,7 @@ type chain struct {
 	Name        string
 	VarName     string
 	NetworkType string
+	Deprecated  bool
 	Passphrase  string
 }
 
@@ -35,12 +36,13 @@ type StellarChain struct {
 	Selector    uint64
 	Name        string
 	NetworkType NetworkType
+	Deprecated  bool
 	Passphrase  string
 }
 
 var (
 	{{- range . }}
-	{{.VarName}} = StellarChain{ChainID: "{{ .ChainID }}",Selector: {{ .Selector }}, Name: "{{ .Name }}", NetworkType: {{ .NetworkType }}, Passphrase: "{{ .Passphrase }}"}
+	{{.VarName}} = StellarChain{ChainID: "{{ .ChainID }}",Selector: {{ .Selector }}, Name: "{{ .Name }}", NetworkType: {{ .NetworkType }}{{ if .Deprecated }}, Deprecated: true{{ end }}, Passphrase: "{{ .Passphrase }}"}
 	{{- end }}
 )
 
@@ -97,12 +99,18 @@ func genChainsSourceCode() (string, error) {
 			return "", err
 		}
 
+		deprecated, err := chain_selectors.IsDeprecated(chainSel)
+		if err != nil {
+			return "", err
+		}
+
 		chains = append(chains, chain{
 			ChainID:     chainID,
 			Selector:    chainSel,
 			Name:        name,
 			VarName:     toVarName(name, chainSel),
 			NetworkType: fmt.Sprintf("NetworkType%s", strings.Title(string(networkType))),
+			Deprecated:  deprecated,
 			Passphrase:  passphrase,
 		})
 	}
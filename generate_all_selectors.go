@@ -80,15 +80,23 @@ func main() {
 	}
 
 	// Read Stellar chains
-	stellarData, err := readStellarYaml("selectors_stellar.yml")
+	stellarData, stellarPassphrases, err := readStellarYaml("selectors_stellar.yml")
 	if err != nil {
 		fmt.Printf("Warning: Could not read selectors_stellar.yml: %v\n", err)
 	} else {
 		result.Stellar = stellarData
 	}
 
+	// Read Cosmos chains
+	cosmosData, err := readCosmosYaml("selectors_cosmos.yml")
+	if err != nil {
+		fmt.Printf("Warning: Could not read selectors_cosmos.yml: %v\n", err)
+	} else {
+		result.Cosmos = cosmosData
+	}
+
 	// Write consolidated output
-	err = writeAllSelectors(outputFilename, result)
+	err = writeAllSelectors(outputFilename, result, stellarPassphrases)
 	if err != nil {
 		fmt.Printf("Error writing %s: %v\n", outputFilename, err)
 		os.Exit(1)
@@ -231,7 +239,40 @@ func readCantonYaml(filename string) (map[string]chain_selectors.ChainDetails, e
 	return parsed.Selectors, nil
 }
 
-func readStellarYaml(filename string) (map[string]chain_selectors.ChainDetails, error) {
+// readStellarYaml returns the Stellar chains and their network passphrases, which are not part of ChainDetails.
+func readStellarYaml(filename string) (map[string]chain_selectors.ChainDetails, map[string]string, error) {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var parsed struct {
+		Selectors map[string]chain_selectors.ChainDetails `yaml:"selectors"`
+	}
+	err = yaml.Unmarshal(data, &parsed)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var passphrases struct {
+		Selectors map[string]struct {
+			Passphrase string `yaml:"passphrase"`
+		} `yaml:"selectors"`
+	}
+	err = yaml.Unmarshal(data, &passphrases)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	passphraseByChainID := make(map[string]string, len(passphrases.Selectors))
+	for chainID, v := range passphrases.Selectors {
+		passphraseByChainID[chainID] = v.Passphrase
+	}
+
+	return parsed.Selectors, passphraseByChainID, nil
+}
+
+func readCosmosYaml(filename string) (map[string]chain_selectors.ChainDetails, error) {
 	data, err := os.ReadFile(filename)
 	if err != nil {
 		return nil, err
@@ -248,15 +289,43 @@ func readStellarYaml(filename string) (map[string]chain_selectors.ChainDetails,
 	return parsed.Selectors, nil
 }
 
-func writeAllSelectors(filename string, data chain_selectors.ExtraSelectorsData) error {
-	output, err := yaml.Marshal(data)
+func writeAllSelectors(filename string, data chain_selectors.ExtraSelectorsData, stellarPassphrases map[string]string) error {
+	var doc yaml.Node
+	if err := doc.Encode(data); err != nil {
+		return err
+	}
+	addStellarPassphrases(&doc, stellarPassphrases)
+
+	output, err := yaml.Marshal(&doc)
 	if err != nil {
 		return err
 	}
 
 	// Add header comment
-	header := []byte("# Consolidated chain selectors for all blockchain families\n# This file is auto-generated by 'go generate'. DO NOT EDIT MANUALLY.\n# Generated from: selectors.yml, selectors_solana.yml, selectors_aptos.yml, selectors_sui.yml, selectors_tron.yml, selectors_ton.yml, selectors_starknet.yml, selectors_canton.yml, selectors_stellar.yml\n\n")
+	header := []byte("# Consolidated chain selectors for all blockchain families\n# This file is auto-generated by 'go generate'. DO NOT EDIT MANUALLY.\n# Generated from: selectors.yml, selectors_solana.yml, selectors_aptos.yml, selectors_sui.yml, selectors_tron.yml, selectors_ton.yml, selectors_starknet.yml, selectors_canton.yml, selectors_stellar.yml, selectors_cosmos.yml\n\n")
 	output = append(header, output...)
 
 	return os.WriteFile(filename, output, 0644)
 }
+
+// addStellarPassphrases adds the network passphrase to every Stellar entry of the encoded document,
+// so that remote consumers can sign transactions for networks they only learn about remotely.
+func addStellarPassphrases(doc *yaml.Node, passphrases map[string]string) {
+	for i := 0; i+1 < len(doc.Content); i += 2 {
+		if doc.Content[i].Value != "stellar" {
+			continue
+		}
+		chains := doc.Content[i+1]
+		for j := 0; j+1 < len(chains.Content); j += 2 {
+			passphrase, exists := passphrases[chains.Content[j].Value]
+			if !exists || passphrase == "" {
+				continue
+			}
+			details := chains.Content[j+1]
+			details.Content = append(details.Content,
+				&yaml.Node{Kind: yaml.ScalarNode, Value: "passphrase"},
+				&yaml.Node{Kind: yaml.ScalarNode, Value: passphrase},
+			)
+		}
+	}
+}
@@ -6,6 +6,7 @@ type CantonChain struct {
 	Selector    uint64
 	Name        string
 	NetworkType NetworkType
+	Deprecated  bool
 }
 
 var (
@@ -6,6 +6,7 @@ type Chain struct {
 	Selector    uint64
 	Name        string
 	NetworkType NetworkType
+	Deprecated  bool
 	VarName     string
 }
 
@@ -29,8 +30,8 @@ var (
 	AVALANCHE_TESTNET_FUJI                         = Chain{EvmChainID: 43113, Selector: 14767482510784806043, Name: "avalanche-testnet-fuji", NetworkType: NetworkTypeTestnet}
 	AVALANCHE_TESTNET_NEXON                        = Chain{EvmChainID: 595581, Selector: 7837562506228496256, Name: "avalanche-testnet-nexon", NetworkType: NetworkTypeTestnet}
 	BERACHAIN_MAINNET                              = Chain{EvmChainID: 80094, Selector: 1294465214383781161, Name: "berachain-mainnet", NetworkType: NetworkTypeMainnet}
-	BERACHAIN_TESTNET_ARTIO                        = Chain{EvmChainID: 80085, Selector: 12336603543561911511, Name: "berachain-testnet-artio", NetworkType: NetworkTypeTestnet}
-	BERACHAIN_TESTNET_BARTIO                       = Chain{EvmChainID: 80084, Selector: 8999465244383784164, Name: "berachain-testnet-bartio", NetworkType: NetworkTypeTestnet}
+	BERACHAIN_TESTNET_ARTIO                        = Chain{EvmChainID: 80085, Selector: 12336603543561911511, Name: "berachain-testnet-artio", NetworkType: NetworkTypeTestnet, Deprecated: true}
+	BERACHAIN_TESTNET_BARTIO                       = Chain{EvmChainID: 80084, Selector: 8999465244383784164, Name: "berachain-testnet-bartio", NetworkType: NetworkTypeTestnet, Deprecated: true}
 	BERACHAIN_TESTNET_BEPOLIA                      = Chain{EvmChainID: 80069, Selector: 7728255861635209484, Name: "berachain-testnet-bepolia", NetworkType: NetworkTypeTestnet}
 	BINANCE_SMART_CHAIN_MAINNET                    = Chain{EvmChainID: 56, Selector: 11344663589394136015, Name: "binance_smart_chain-mainnet", NetworkType: NetworkTypeMainnet}
 	BINANCE_SMART_CHAIN_MAINNET_OPBNB_1            = Chain{EvmChainID: 204, Selector: 465944652040885897, Name: "binance_smart_chain-mainnet-opbnb-1", NetworkType: NetworkTypeMainnet}
@@ -40,12 +41,12 @@ var (
 	BITCICHAIN_TESTNET                             = Chain{EvmChainID: 1908, Selector: 4888058894222120000, Name: "bitcichain-testnet", NetworkType: NetworkTypeTestnet}
 	BITCOIN_MAINNET_BITLAYER_1                     = Chain{EvmChainID: 200901, Selector: 7937294810946806131, Name: "bitcoin-mainnet-bitlayer-1", NetworkType: NetworkTypeMainnet}
 	BITCOIN_MAINNET_BOB_1                          = Chain{EvmChainID: 60808, Selector: 3849287863852499584, Name: "bitcoin-mainnet-bob-1", NetworkType: NetworkTypeMainnet}
-	BITCOIN_MAINNET_BOTANIX                        = Chain{EvmChainID: 3637, Selector: 4560701533377838164, Name: "bitcoin-mainnet-botanix", NetworkType: NetworkTypeMainnet}
+	BITCOIN_MAINNET_BOTANIX                        = Chain{EvmChainID: 3637, Selector: 4560701533377838164, Name: "bitcoin-mainnet-botanix", NetworkType: NetworkTypeMainnet, Deprecated: true}
 	BITCOIN_MAINNET_BSQUARED_1                     = Chain{EvmChainID: 223, Selector: 5406759801798337480, Name: "bitcoin-mainnet-bsquared-1", NetworkType: NetworkTypeMainnet}
 	BITCOIN_MERLIN_MAINNET                         = Chain{EvmChainID: 4200, Selector: 241851231317828981, Name: "bitcoin-merlin-mainnet", NetworkType: NetworkTypeMainnet}
 	BITCOIN_TESTNET_BITLAYER_1                     = Chain{EvmChainID: 200810, Selector: 3789623672476206327, Name: "bitcoin-testnet-bitlayer-1", NetworkType: NetworkTypeTestnet}
-	BITCOIN_TESTNET_BOTANIX                        = Chain{EvmChainID: 3636, Selector: 1467223411771711614, Name: "bitcoin-testnet-botanix", NetworkType: NetworkTypeTestnet}
-	BITCOIN_TESTNET_BSQUARED_1                     = Chain{EvmChainID: 1123, Selector: 1948510578179542068, Name: "bitcoin-testnet-bsquared-1", NetworkType: NetworkTypeTestnet}
+	BITCOIN_TESTNET_BOTANIX                        = Chain{EvmChainID: 3636, Selector: 1467223411771711614, Name: "bitcoin-testnet-botanix", NetworkType: NetworkTypeTestnet, Deprecated: true}
+	BITCOIN_TESTNET_BSQUARED_1                     = Chain{EvmChainID: 1123, Selector: 1948510578179542068, Name: "bitcoin-testnet-bsquared-1", NetworkType: NetworkTypeTestnet, Deprecated: true}
 	BITCOIN_TESTNET_MERLIN                         = Chain{EvmChainID: 686868, Selector: 5269261765892944301, Name: "bitcoin-testnet-merlin", NetworkType: NetworkTypeTestnet}
 	BITCOIN_TESTNET_ROOTSTOCK                      = Chain{EvmChainID: 31, Selector: 8953668971247136127, Name: "bitcoin-testnet-rootstock", NetworkType: NetworkTypeTestnet}
 	BITCOIN_TESTNET_SEPOLIA_BOB_1                  = Chain{EvmChainID: 808813, Selector: 5535534526963509396, Name: "bitcoin-testnet-sepolia-bob-1", NetworkType: NetworkTypeTestnet}
@@ -55,7 +56,7 @@ var (
 	BITTORRENT_CHAIN_TESTNET                       = Chain{EvmChainID: 1029, Selector: 4459371029167934217, Name: "bittorrent_chain-testnet", NetworkType: NetworkTypeTestnet}
 	CELO_MAINNET                                   = Chain{EvmChainID: 42220, Selector: 1346049177634351622, Name: "celo-mainnet", NetworkType: NetworkTypeMainnet}
 	CELO_SEPOLIA                                   = Chain{EvmChainID: 11142220, Selector: 3761762704474186180, Name: "celo-sepolia", NetworkType: NetworkTypeTestnet}
-	CELO_TESTNET_ALFAJORES                         = Chain{EvmChainID: 44787, Selector: 3552045678561919002, Name: "celo-testnet-alfajores", NetworkType: NetworkTypeTestnet}
+	CELO_TESTNET_ALFAJORES                         = Chain{EvmChainID: 44787, Selector: 3552045678561919002, Name: "celo-testnet-alfajores", NetworkType: NetworkTypeTestnet, Deprecated: true}
 	CODEX_MAINNET                                  = Chain{EvmChainID: 81224, Selector: 9478124434908827753, Name: "codex-mainnet", NetworkType: NetworkTypeMainnet}
 	CODEX_TESTNET                                  = Chain{EvmChainID: 812242, Selector: 7225665875429174318, Name: "codex-testnet", NetworkType: NetworkTypeTestnet}
 	COINEX_SMART_CHAIN_MAINNET                     = Chain{EvmChainID: 52, Selector: 1761333065194157300, Name: "coinex_smart_chain-mainnet", NetworkType: NetworkTypeMainnet}
@@ -63,7 +64,7 @@ var (
 	CONFLUX_MAINNET                                = Chain{EvmChainID: 1030, Selector: 3358365939762719202, Name: "conflux-mainnet", NetworkType: NetworkTypeMainnet}
 	CORE_MAINNET                                   = Chain{EvmChainID: 1116, Selector: 1224752112135636129, Name: "core-mainnet", NetworkType: NetworkTypeMainnet}
 	CORE_TESTNET                                   = Chain{EvmChainID: 1114, Selector: 4264732132125536123, Name: "core-testnet", NetworkType: NetworkTypeTestnet}
-	CORN_MAINNET                                   = Chain{EvmChainID: 21000000, Selector: 9043146809313071210, Name: "corn-mainnet", NetworkType: NetworkTypeMainnet}
+	CORN_MAINNET                                   = Chain{EvmChainID: 21000000, Selector: 9043146809313071210, Name: "corn-mainnet", NetworkType: NetworkTypeMainnet, Deprecated: true}
 	CREDITCOIN_MAINNET                             = Chain{EvmChainID: 102030, Selector: 18240105181246962294, Name: "creditcoin-mainnet", NetworkType: NetworkTypeMainnet}
 	CREDITCOIN_TESTNET                             = Chain{EvmChainID: 102031, Selector: 16960985330067274105, Name: "creditcoin-testnet", NetworkType: NetworkTypeTestnet}
 	CRONOS_MAINNET                                 = Chain{EvmChainID: 25, Selector: 1456215246176062136, Name: "cronos-mainnet", NetworkType: NetworkTypeMainnet}
@@ -79,20 +80,20 @@ var (
 	ETHEREUM_MAINNET                               = Chain{EvmChainID: 1, Selector: 5009297550715157269, Name: "ethereum-mainnet", NetworkType: NetworkTypeMainnet}
 	ETHEREUM_MAINNET_ARBITRUM_1                    = Chain{EvmChainID: 42161, Selector: 4949039107694359620, Name: "ethereum-mainnet-arbitrum-1", NetworkType: NetworkTypeMainnet}
 	ETHEREUM_MAINNET_ARBITRUM_1_L3X_1              = Chain{EvmChainID: 12324, Selector: 3162193654116181371, Name: "ethereum-mainnet-arbitrum-1-l3x-1", NetworkType: NetworkTypeMainnet}
-	ETHEREUM_MAINNET_ARBITRUM_1_TREASURE_1         = Chain{EvmChainID: 978670, Selector: 1010349088906777999, Name: "ethereum-mainnet-arbitrum-1-treasure-1", NetworkType: NetworkTypeMainnet}
+	ETHEREUM_MAINNET_ARBITRUM_1_TREASURE_1         = Chain{EvmChainID: 978670, Selector: 1010349088906777999, Name: "ethereum-mainnet-arbitrum-1-treasure-1", NetworkType: NetworkTypeMainnet, Deprecated: true}
 	ETHEREUM_MAINNET_ASTAR_ZKEVM_1                 = Chain{EvmChainID: 3776, Selector: 1540201334317828111, Name: "ethereum-mainnet-astar-zkevm-1", NetworkType: NetworkTypeMainnet}
 	ETHEREUM_MAINNET_BASE_1                        = Chain{EvmChainID: 8453, Selector: 15971525489660198786, Name: "ethereum-mainnet-base-1", NetworkType: NetworkTypeMainnet}
-	ETHEREUM_MAINNET_BLAST_1                       = Chain{EvmChainID: 81457, Selector: 4411394078118774322, Name: "ethereum-mainnet-blast-1", NetworkType: NetworkTypeMainnet}
+	ETHEREUM_MAINNET_BLAST_1                       = Chain{EvmChainID: 81457, Selector: 4411394078118774322, Name: "ethereum-mainnet-blast-1", NetworkType: NetworkTypeMainnet, Deprecated: true}
 	ETHEREUM_MAINNET_HASHKEY_1                     = Chain{EvmChainID: 177, Selector: 7613811247471741961, Name: "ethereum-mainnet-hashkey-1", NetworkType: NetworkTypeMainnet}
 	ETHEREUM_MAINNET_IMMUTABLE_ZKEVM_1             = Chain{EvmChainID: 13371, Selector: 1237925231416731909, Name: "ethereum-mainnet-immutable-zkevm-1", NetworkType: NetworkTypeMainnet}
 	ETHEREUM_MAINNET_INK_1                         = Chain{EvmChainID: 57073, Selector: 3461204551265785888, Name: "ethereum-mainnet-ink-1", NetworkType: NetworkTypeMainnet}
-	ETHEREUM_MAINNET_KROMA_1                       = Chain{EvmChainID: 255, Selector: 3719320017875267166, Name: "ethereum-mainnet-kroma-1", NetworkType: NetworkTypeMainnet}
+	ETHEREUM_MAINNET_KROMA_1                       = Chain{EvmChainID: 255, Selector: 3719320017875267166, Name: "ethereum-mainnet-kroma-1", NetworkType: NetworkTypeMainnet, Deprecated: true}
 	ETHEREUM_MAINNET_LINEA_1                       = Chain{EvmChainID: 59144, Selector: 4627098889531055414, Name: "ethereum-mainnet-linea-1", NetworkType: NetworkTypeMainnet}
 	ETHEREUM_MAINNET_MANTLE_1                      = Chain{EvmChainID: 5000, Selector: 1556008542357238666, Name: "ethereum-mainnet-mantle-1", NetworkType: NetworkTypeMainnet}
 	ETHEREUM_MAINNET_METIS_1                       = Chain{EvmChainID: 1088, Selector: 8805746078405598895, Name: "ethereum-mainnet-metis-1", NetworkType: NetworkTypeMainnet}
 	ETHEREUM_MAINNET_MODE_1                        = Chain{EvmChainID: 34443, Selector: 7264351850409363825, Name: "ethereum-mainnet-mode-1", NetworkType: NetworkTypeMainnet}
 	ETHEREUM_MAINNET_OPTIMISM_1                    = Chain{EvmChainID: 10, Selector: 3734403246176062136, Name: "ethereum-mainnet-optimism-1", NetworkType: NetworkTypeMainnet}
-	ETHEREUM_MAINNET_POLYGON_ZKEVM_1               = Chain{EvmChainID: 1101, Selector: 4348158687435793198, Name: "ethereum-mainnet-polygon-zkevm-1", NetworkType: NetworkTypeMainnet}
+	ETHEREUM_MAINNET_POLYGON_ZKEVM_1               = Chain{EvmChainID: 1101, Selector: 4348158687435793198, Name: "ethereum-mainnet-polygon-zkevm-1", NetworkType: NetworkTypeMainnet, Deprecated: true}
 	ETHEREUM_MAINNET_SCROLL_1                      = Chain{EvmChainID: 534352, Selector: 13204309965629103672, Name: "ethereum-mainnet-scroll-1", NetworkType: NetworkTypeMainnet}
 	ETHEREUM_MAINNET_TAIKO_1                       = Chain{EvmChainID: 167000, Selector: 16468599424800719238, Name: "ethereum-mainnet-taiko-1", NetworkType: NetworkTypeMainnet}
 	ETHEREUM_MAINNET_UNICHAIN_1                    = Chain{EvmChainID: 130, Selector: 1923510103922296319, Name: "ethereum-mainnet-unichain-1", NetworkType: NetworkTypeMainnet}
@@ -105,12 +106,12 @@ var (
 	ETHEREUM_TESTNET_GOERLI_LINEA_1                = Chain{EvmChainID: 59140, Selector: 1355246678561316402, Name: "ethereum-testnet-goerli-linea-1", NetworkType: NetworkTypeTestnet}
 	ETHEREUM_TESTNET_GOERLI_MANTLE_1               = Chain{EvmChainID: 5001, Selector: 4168263376276232250, Name: "ethereum-testnet-goerli-mantle-1", NetworkType: NetworkTypeTestnet}
 	ETHEREUM_TESTNET_GOERLI_OPTIMISM_1             = Chain{EvmChainID: 420, Selector: 2664363617261496610, Name: "ethereum-testnet-goerli-optimism-1", NetworkType: NetworkTypeTestnet}
-	ETHEREUM_TESTNET_GOERLI_POLYGON_ZKEVM_1        = Chain{EvmChainID: 1442, Selector: 11059667695644972511, Name: "ethereum-testnet-goerli-polygon-zkevm-1", NetworkType: NetworkTypeTestnet}
+	ETHEREUM_TESTNET_GOERLI_POLYGON_ZKEVM_1        = Chain{EvmChainID: 1442, Selector: 11059667695644972511, Name: "ethereum-testnet-goerli-polygon-zkevm-1", NetworkType: NetworkTypeTestnet, Deprecated: true}
 	ETHEREUM_TESTNET_GOERLI_ZKSYNC_1               = Chain{EvmChainID: 280, Selector: 6802309497652714138, Name: "ethereum-testnet-goerli-zksync-1", NetworkType: NetworkTypeTestnet}
-	ETHEREUM_TESTNET_HOLESKY                       = Chain{EvmChainID: 17000, Selector: 7717148896336251131, Name: "ethereum-testnet-holesky", NetworkType: NetworkTypeTestnet}
-	ETHEREUM_TESTNET_HOLESKY_FRAXTAL_1             = Chain{EvmChainID: 2522, Selector: 8901520481741771655, Name: "ethereum-testnet-holesky-fraxtal-1", NetworkType: NetworkTypeTestnet}
-	ETHEREUM_TESTNET_HOLESKY_MORPH_1               = Chain{EvmChainID: 2810, Selector: 8304510386741731151, Name: "ethereum-testnet-holesky-morph-1", NetworkType: NetworkTypeTestnet}
-	ETHEREUM_TESTNET_HOLESKY_TAIKO_1               = Chain{EvmChainID: 167009, Selector: 7248756420937879088, Name: "ethereum-testnet-holesky-taiko-1", NetworkType: NetworkTypeTestnet}
+	ETHEREUM_TESTNET_HOLESKY                       = Chain{EvmChainID: 17000, Selector: 7717148896336251131, Name: "ethereum-testnet-holesky", NetworkType: NetworkTypeTestnet, Deprecated: true}
+	ETHEREUM_TESTNET_HOLESKY_FRAXTAL_1             = Chain{EvmChainID: 2522, Selector: 8901520481741771655, Name: "ethereum-testnet-holesky-fraxtal-1", NetworkType: NetworkTypeTestnet, Deprecated: true}
+	ETHEREUM_TESTNET_HOLESKY_MORPH_1               = Chain{EvmChainID: 2810, Selector: 8304510386741731151, Name: "ethereum-testnet-holesky-morph-1", NetworkType: NetworkTypeTestnet, Deprecated: true}
+	ETHEREUM_TESTNET_HOLESKY_TAIKO_1               = Chain{EvmChainID: 167009, Selector: 7248756420937879088, Name: "ethereum-testnet-holesky-taiko-1", NetworkType: NetworkTypeTestnet, Deprecated: true}
 	ETHEREUM_TESTNET_HOODI                         = Chain{EvmChainID: 560048, Selector: 10380998176179737091, Name: "ethereum-testnet-hoodi", NetworkType: NetworkTypeTestnet}
 	ETHEREUM_TESTNET_HOODI_MORPH                   = Chain{EvmChainID: 2910, Selector: 1064004874793747259, Name: "ethereum-testnet-hoodi-morph", NetworkType: NetworkTypeTestnet}
 	ETHEREUM_TESTNET_HOODI_TAIKO                   = Chain{EvmChainID: 167012, Selector: 9873759436596923887, Name: "ethereum-testnet-hoodi-taiko", NetworkType: NetworkTypeTestnet}
@@ -118,13 +119,13 @@ var (
 	ETHEREUM_TESTNET_SEPOLIA                       = Chain{EvmChainID: 11155111, Selector: 16015286601757825753, Name: "ethereum-testnet-sepolia", NetworkType: NetworkTypeTestnet}
 	ETHEREUM_TESTNET_SEPOLIA_ARBITRUM_1            = Chain{EvmChainID: 421614, Selector: 3478487238524512106, Name: "ethereum-testnet-sepolia-arbitrum-1", NetworkType: NetworkTypeTestnet}
 	ETHEREUM_TESTNET_SEPOLIA_ARBITRUM_1_L3X_1      = Chain{EvmChainID: 12325, Selector: 3486622437121596122, Name: "ethereum-testnet-sepolia-arbitrum-1-l3x-1", NetworkType: NetworkTypeTestnet}
-	ETHEREUM_TESTNET_SEPOLIA_ARBITRUM_1_TREASURE_1 = Chain{EvmChainID: 978657, Selector: 10443705513486043421, Name: "ethereum-testnet-sepolia-arbitrum-1-treasure-1", NetworkType: NetworkTypeTestnet}
+	ETHEREUM_TESTNET_SEPOLIA_ARBITRUM_1_TREASURE_1 = Chain{EvmChainID: 978657, Selector: 10443705513486043421, Name: "ethereum-testnet-sepolia-arbitrum-1-treasure-1", NetworkType: NetworkTypeTestnet, Deprecated: true}
 	ETHEREUM_TESTNET_SEPOLIA_BASE_1                = Chain{EvmChainID: 84532, Selector: 10344971235874465080, Name: "ethereum-testnet-sepolia-base-1", NetworkType: NetworkTypeTestnet}
-	ETHEREUM_TESTNET_SEPOLIA_BLAST_1               = Chain{EvmChainID: 168587773, Selector: 2027362563942762617, Name: "ethereum-testnet-sepolia-blast-1", NetworkType: NetworkTypeTestnet}
-	ETHEREUM_TESTNET_SEPOLIA_CORN_1                = Chain{EvmChainID: 21000001, Selector: 1467427327723633929, Name: "ethereum-testnet-sepolia-corn-1", NetworkType: NetworkTypeTestnet}
+	ETHEREUM_TESTNET_SEPOLIA_BLAST_1               = Chain{EvmChainID: 168587773, Selector: 2027362563942762617, Name: "ethereum-testnet-sepolia-blast-1", NetworkType: NetworkTypeTestnet, Deprecated: true}
+	ETHEREUM_TESTNET_SEPOLIA_CORN_1                = Chain{EvmChainID: 21000001, Selector: 1467427327723633929, Name: "ethereum-testnet-sepolia-corn-1", NetworkType: NetworkTypeTestnet, Deprecated: true}
 	ETHEREUM_TESTNET_SEPOLIA_HASHKEY_1             = Chain{EvmChainID: 133, Selector: 4356164186791070119, Name: "ethereum-testnet-sepolia-hashkey-1", NetworkType: NetworkTypeTestnet}
 	ETHEREUM_TESTNET_SEPOLIA_IMMUTABLE_ZKEVM_1     = Chain{EvmChainID: 13473, Selector: 4526165231216331901, Name: "ethereum-testnet-sepolia-immutable-zkevm-1", NetworkType: NetworkTypeTestnet}
-	ETHEREUM_TESTNET_SEPOLIA_KROMA_1               = Chain{EvmChainID: 2358, Selector: 5990477251245693094, Name: "ethereum-testnet-sepolia-kroma-1", NetworkType: NetworkTypeTestnet}
+	ETHEREUM_TESTNET_SEPOLIA_KROMA_1               = Chain{EvmChainID: 2358, Selector: 5990477251245693094, Name: "ethereum-testnet-sepolia-kroma-1", NetworkType: NetworkTypeTestnet, Deprecated: true}
 	ETHEREUM_TESTNET_SEPOLIA_LENS_1                = Chain{EvmChainID: 37111, Selector: 6827576821754315911, Name: "ethereum-testnet-sepolia-lens-1", NetworkType: NetworkTypeTestnet}
 	ETHEREUM_TESTNET_SEPOLIA_LINEA_1               = Chain{EvmChainID: 59141, Selector: 5719461335882077547, Name: "ethereum-testnet-sepolia-linea-1", NetworkType: NetworkTypeTestnet}
 	ETHEREUM_TESTNET_SEPOLIA_LISK_1                = Chain{EvmChainID: 4202, Selector: 5298399861320400553, Name: "ethereum-testnet-sepolia-lisk-1", NetworkType: NetworkTypeTestnet}
@@ -139,12 +140,12 @@ var (
 	ETHEREUM_TESTNET_SEPOLIA_SONEIUM_1             = Chain{EvmChainID: 1946, Selector: 686603546605904534, Name: "ethereum-testnet-sepolia-soneium-1", NetworkType: NetworkTypeTestnet}
 	ETHEREUM_TESTNET_SEPOLIA_UNICHAIN_1            = Chain{EvmChainID: 1301, Selector: 14135854469784514356, Name: "ethereum-testnet-sepolia-unichain-1", NetworkType: NetworkTypeTestnet}
 	ETHEREUM_TESTNET_SEPOLIA_WORLDCHAIN_1          = Chain{EvmChainID: 4801, Selector: 5299555114858065850, Name: "ethereum-testnet-sepolia-worldchain-1", NetworkType: NetworkTypeTestnet}
-	ETHEREUM_TESTNET_SEPOLIA_XLAYER_1              = Chain{EvmChainID: 195, Selector: 2066098519157881736, Name: "ethereum-testnet-sepolia-xlayer-1", NetworkType: NetworkTypeTestnet}
+	ETHEREUM_TESTNET_SEPOLIA_XLAYER_1              = Chain{EvmChainID: 195, Selector: 2066098519157881736, Name: "ethereum-testnet-sepolia-xlayer-1", NetworkType: NetworkTypeTestnet, Deprecated: true}
 	ETHEREUM_TESTNET_SEPOLIA_ZIRCUIT_1             = Chain{EvmChainID: 48899, Selector: 4562743618362911021, Name: "ethereum-testnet-sepolia-zircuit-1", NetworkType: NetworkTypeTestnet}
 	ETHEREUM_TESTNET_SEPOLIA_ZKSYNC_1              = Chain{EvmChainID: 300, Selector: 6898391096552792247, Name: "ethereum-testnet-sepolia-zksync-1", NetworkType: NetworkTypeTestnet}
 	ETHERLINK_MAINNET                              = Chain{EvmChainID: 42793, Selector: 13624601974233774587, Name: "etherlink-mainnet", NetworkType: NetworkTypeMainnet}
-	ETHERLINK_TESTNET                              = Chain{EvmChainID: 128123, Selector: 1910019406958449359, Name: "etherlink-testnet", NetworkType: NetworkTypeTestnet}
-	EVERCLEAR_MAINNET                              = Chain{EvmChainID: 25327, Selector: 9723842205701363942, Name: "everclear-mainnet", NetworkType: NetworkTypeMainnet}
+	ETHERLINK_TESTNET                              = Chain{EvmChainID: 128123, Selector: 1910019406958449359, Name: "etherlink-testnet", NetworkType: NetworkTypeTestnet, Deprecated: true}
+	EVERCLEAR_MAINNET                              = Chain{EvmChainID: 25327, Selector: 9723842205701363942, Name: "everclear-mainnet", NetworkType: NetworkTypeMainnet, Deprecated: true}
 	EVERCLEAR_TESTNET_SEPOLIA                      = Chain{EvmChainID: 6398, Selector: 379340054879810246, Name: "everclear-testnet-sepolia", NetworkType: NetworkTypeTestnet}
 	FANTOM_MAINNET                                 = Chain{EvmChainID: 250, Selector: 3768048213127883732, Name: "fantom-mainnet", NetworkType: NetworkTypeMainnet}
 	FANTOM_TESTNET                                 = Chain{EvmChainID: 4002, Selector: 4905564228793744293, Name: "fantom-testnet", NetworkType: NetworkTypeTestnet}
@@ -158,7 +159,7 @@ var (
 	GETH_DEVNET_2                                  = Chain{EvmChainID: 2337, Selector: 12922642891491394802, Name: "geth-devnet-2", NetworkType: NetworkTypeTestnet}
 	GETH_DEVNET_3                                  = Chain{EvmChainID: 3337, Selector: 4793464827907405086, Name: "geth-devnet-3", NetworkType: NetworkTypeTestnet}
 	GETH_TESTNET                                   = Chain{EvmChainID: 1337, Selector: 3379446385462418246, Name: "geth-testnet", NetworkType: NetworkTypeTestnet}
-	GLAMSTERDAM_DEVNET_5                           = Chain{EvmChainID: 7095321190, Selector: 10073034426865795585, Name: "glamsterdam-devnet-5", NetworkType: NetworkTypeTestnet}
+	GLAMSTERDAM_DEVNET_5                           = Chain{EvmChainID: 7095321190, Selector: 10073034426865795585, Name: "glamsterdam-devnet-5", NetworkType: NetworkTypeTestnet, Deprecated: true}
 	GLAMSTERDAM_DEVNET_6                           = Chain{EvmChainID: 7052886157, Selector: 410896468069059699, Name: "glamsterdam-devnet-6", NetworkType: NetworkTypeTestnet}
 	GNOSIS_CHAIN_MAINNET                           = Chain{EvmChainID: 100, Selector: 465200170687744372, Name: "gnosis_chain-mainnet", NetworkType: NetworkTypeMainnet}
 	GNOSIS_CHAIN_TESTNET_CHIADO                    = Chain{EvmChainID: 10200, Selector: 8871595565390010547, Name: "gnosis_chain-testnet-chiado", NetworkType: NetworkTypeTestnet}
@@ -170,7 +171,7 @@ var (
 	HYPERLIQUID_TESTNET                            = Chain{EvmChainID: 998, Selector: 4286062357653186312, Name: "hyperliquid-testnet", NetworkType: NetworkTypeTestnet}
 	INK_TESTNET_SEPOLIA                            = Chain{EvmChainID: 763373, Selector: 9763904284804119144, Name: "ink-testnet-sepolia", NetworkType: NetworkTypeTestnet}
 	JANCTION_MAINNET                               = Chain{EvmChainID: 678, Selector: 9107126442626377432, Name: "janction-mainnet", NetworkType: NetworkTypeMainnet}
-	JANCTION_TESTNET_SEPOLIA                       = Chain{EvmChainID: 679, Selector: 5059197667603797935, Name: "janction-testnet-sepolia", NetworkType: NetworkTypeTestnet}
+	JANCTION_TESTNET_SEPOLIA                       = Chain{EvmChainID: 679, Selector: 5059197667603797935, Name: "janction-testnet-sepolia", NetworkType: NetworkTypeTestnet, Deprecated: true}
 	JOVAY_MAINNET                                  = Chain{EvmChainID: 5734951, Selector: 1523760397290643893, Name: "jovay-mainnet", NetworkType: NetworkTypeMainnet}
 	JOVAY_TESTNET                                  = Chain{EvmChainID: 2019775, Selector: 945045181441419236, Name: "jovay-testnet", NetworkType: NetworkTypeTestnet}
 	KAIA_MAINNET                                   = Chain{EvmChainID: 8217, Selector: 9813823125703490621, Name: "kaia-mainnet", NetworkType: NetworkTypeMainnet}
@@ -181,20 +182,20 @@ var (
 	LENS_MAINNET                                   = Chain{EvmChainID: 232, Selector: 5608378062013572713, Name: "lens-mainnet", NetworkType: NetworkTypeMainnet}
 	LISK_MAINNET                                   = Chain{EvmChainID: 1135, Selector: 15293031020466096408, Name: "lisk-mainnet", NetworkType: NetworkTypeMainnet}
 	MEGAETH_MAINNET                                = Chain{EvmChainID: 4326, Selector: 6093540873831549674, Name: "megaeth-mainnet", NetworkType: NetworkTypeMainnet}
-	MEGAETH_TESTNET                                = Chain{EvmChainID: 6342, Selector: 2443239559770384419, Name: "megaeth-testnet", NetworkType: NetworkTypeTestnet}
+	MEGAETH_TESTNET                                = Chain{EvmChainID: 6342, Selector: 2443239559770384419, Name: "megaeth-testnet", NetworkType: NetworkTypeTestnet, Deprecated: true}
 	MEGAETH_TESTNET_2                              = Chain{EvmChainID: 6343, Selector: 18241817625092392675, Name: "megaeth-testnet-2", NetworkType: NetworkTypeTestnet}
-	MEMENTO_MAINNET                                = Chain{EvmChainID: 51888, Selector: 6473245816409426016, Name: "memento-mainnet", NetworkType: NetworkTypeMainnet}
-	MEMENTO_TESTNET                                = Chain{EvmChainID: 2129, Selector: 12168171414969487009, Name: "memento-testnet", NetworkType: NetworkTypeTestnet}
+	MEMENTO_MAINNET                                = Chain{EvmChainID: 51888, Selector: 6473245816409426016, Name: "memento-mainnet", NetworkType: NetworkTypeMainnet, Deprecated: true}
+	MEMENTO_TESTNET                                = Chain{EvmChainID: 2129, Selector: 12168171414969487009, Name: "memento-testnet", NetworkType: NetworkTypeTestnet, Deprecated: true}
 	METAL_MAINNET                                  = Chain{EvmChainID: 1750, Selector: 13447077090413146373, Name: "metal-mainnet", NetworkType: NetworkTypeMainnet}
 	METAL_TESTNET                                  = Chain{EvmChainID: 1740, Selector: 6286293440461807648, Name: "metal-testnet", NetworkType: NetworkTypeTestnet}
-	MIND_MAINNET                                   = Chain{EvmChainID: 228, Selector: 11690709103138290329, Name: "mind-mainnet", NetworkType: NetworkTypeMainnet}
-	MIND_TESTNET                                   = Chain{EvmChainID: 192940, Selector: 7189150270347329685, Name: "mind-testnet", NetworkType: NetworkTypeTestnet}
-	MINT_MAINNET                                   = Chain{EvmChainID: 185, Selector: 17164792800244661392, Name: "mint-mainnet", NetworkType: NetworkTypeMainnet}
-	MINT_TESTNET                                   = Chain{EvmChainID: 1687, Selector: 10749384167430721561, Name: "mint-testnet", NetworkType: NetworkTypeTestnet}
+	MIND_MAINNET                                   = Chain{EvmChainID: 228, Selector: 11690709103138290329, Name: "mind-mainnet", NetworkType: NetworkTypeMainnet, Deprecated: true}
+	MIND_TESTNET                                   = Chain{EvmChainID: 192940, Selector: 7189150270347329685, Name: "mind-testnet", NetworkType: NetworkTypeTestnet, Deprecated: true}
+	MINT_MAINNET                                   = Chain{EvmChainID: 185, Selector: 17164792800244661392, Name: "mint-mainnet", NetworkType: NetworkTypeMainnet, Deprecated: true}
+	MINT_TESTNET                                   = Chain{EvmChainID: 1687, Selector: 10749384167430721561, Name: "mint-testnet", NetworkType: NetworkTypeTestnet, Deprecated: true}
 	MONAD_MAINNET                                  = Chain{EvmChainID: 143, Selector: 8481857512324358265, Name: "monad-mainnet", NetworkType: NetworkTypeMainnet}
 	MONAD_TESTNET                                  = Chain{EvmChainID: 10143, Selector: 2183018362218727504, Name: "monad-testnet", NetworkType: NetworkTypeTestnet}
 	MORPH_MAINNET                                  = Chain{EvmChainID: 2818, Selector: 18164309074156128038, Name: "morph-mainnet", NetworkType: NetworkTypeMainnet}
-	MOVA_MAINNET                                   = Chain{EvmChainID: 61900, Selector: 3314641565992046393, Name: "mova-mainnet", NetworkType: NetworkTypeMainnet}
+	MOVA_MAINNET                                   = Chain{EvmChainID: 61900, Selector: 3314641565992046393, Name: "mova-mainnet", NetworkType: NetworkTypeMainnet, Deprecated: true}
 	MOVA_MAINNET_2                                 = Chain{EvmChainID: 61901, Selector: 4215185756725900654, Name: "mova-mainnet-2", NetworkType: NetworkTypeMainnet}
 	MOVA_TESTNET                                   = Chain{EvmChainID: 10323, Selector: 9211758560309513668, Name: "mova-testnet", NetworkType: NetworkTypeTestnet}
 	NEAR_MAINNET                                   = Chain{EvmChainID: 397, Selector: 2039744413822257700, Name: "near-mainnet", NetworkType: NetworkTypeMainnet}
@@ -213,7 +214,7 @@ var (
 	ONDO_TESTNET                                   = Chain{EvmChainID: 9000, Selector: 344208382356656551, Name: "ondo-testnet", NetworkType: NetworkTypeTestnet}
 	PHAROS_ATLANTIC_TESTNET                        = Chain{EvmChainID: 688689, Selector: 16098325658947243212, Name: "pharos-atlantic-testnet", NetworkType: NetworkTypeTestnet}
 	PHAROS_MAINNET                                 = Chain{EvmChainID: 1672, Selector: 7801139999541420232, Name: "pharos-mainnet", NetworkType: NetworkTypeMainnet}
-	PHAROS_TESTNET                                 = Chain{EvmChainID: 688688, Selector: 4012524741200567430, Name: "pharos-testnet", NetworkType: NetworkTypeTestnet}
+	PHAROS_TESTNET                                 = Chain{EvmChainID: 688688, Selector: 4012524741200567430, Name: "pharos-testnet", NetworkType: NetworkTypeTestnet, Deprecated: true}
 	PLASMA_MAINNET                                 = Chain{EvmChainID: 9745, Selector: 9335212494177455608, Name: "plasma-mainnet", NetworkType: NetworkTypeMainnet}
 	PLASMA_TESTNET                                 = Chain{EvmChainID: 9746, Selector: 3967220077692964309, Name: "plasma-testnet", NetworkType: NetworkTypeTestnet}
 	PLUME_DEVNET                                   = Chain{EvmChainID: 98864, Selector: 3743020999916460931, Name: "plume-devnet", NetworkType: NetworkTypeTestnet}
@@ -232,7 +233,7 @@ var (
 	POLYGON_MAINNET_KATANA                         = Chain{EvmChainID: 747474, Selector: 2459028469735686113, Name: "polygon-mainnet-katana", NetworkType: NetworkTypeMainnet}
 	POLYGON_TESTNET_AMOY                           = Chain{EvmChainID: 80002, Selector: 16281711391670634445, Name: "polygon-testnet-amoy", NetworkType: NetworkTypeTestnet}
 	POLYGON_TESTNET_MUMBAI                         = Chain{EvmChainID: 80001, Selector: 12532609583862916517, Name: "polygon-testnet-mumbai", NetworkType: NetworkTypeTestnet}
-	POLYGON_TESTNET_TATARA                         = Chain{EvmChainID: 129399, Selector: 9090863410735740267, Name: "polygon-testnet-tatara", NetworkType: NetworkTypeTestnet}
+	POLYGON_TESTNET_TATARA                         = Chain{EvmChainID: 129399, Selector: 9090863410735740267, Name: "polygon-testnet-tatara", NetworkType: NetworkTypeTestnet, Deprecated: true}
 	PRIVATE_TESTNET_ANDESITE                       = Chain{EvmChainID: 2024, Selector: 6915682381028791124, Name: "private-testnet-andesite", NetworkType: NetworkTypeTestnet}
 	PRIVATE_TESTNET_GRANITE                        = Chain{EvmChainID: 2023, Selector: 3260900564719373474, Name: "private-testnet-granite", NetworkType: NetworkTypeTestnet}
 	PRIVATE_TESTNET_MICA                           = Chain{EvmChainID: 424242, Selector: 4489326297382772450, Name: "private-testnet-mica", NetworkType: NetworkTypeTestnet}
@@ -244,7 +245,7 @@ var (
 	ROBINHOOD_MAINNET                              = Chain{EvmChainID: 4663, Selector: 6180753054346818345, Name: "robinhood-mainnet", NetworkType: NetworkTypeMainnet}
 	ROBINHOOD_TESTNET                              = Chain{EvmChainID: 46630, Selector: 2032988798112970440, Name: "robinhood-testnet", NetworkType: NetworkTypeTestnet}
 	RONIN_MAINNET                                  = Chain{EvmChainID: 2020, Selector: 6916147374840168594, Name: "ronin-mainnet", NetworkType: NetworkTypeMainnet}
-	RONIN_TESTNET_SAIGON                           = Chain{EvmChainID: 2021, Selector: 13116810400804392105, Name: "ronin-testnet-saigon", NetworkType: NetworkTypeTestnet}
+	RONIN_TESTNET_SAIGON                           = Chain{EvmChainID: 2021, Selector: 13116810400804392105, Name: "ronin-testnet-saigon", NetworkType: NetworkTypeTestnet, Deprecated: true}
 	ROOTSTOCK_MAINNET                              = Chain{EvmChainID: 30, Selector: 11964252391146578476, Name: "rootstock-mainnet", NetworkType: NetworkTypeMainnet}
 	SEI_MAINNET                                    = Chain{EvmChainID: 1329, Selector: 9027416829622342829, Name: "sei-mainnet", NetworkType: NetworkTypeMainnet}
 	SEI_TESTNET_ATLANTIC                           = Chain{EvmChainID: 1328, Selector: 1216300075444106652, Name: "sei-testnet-atlantic", NetworkType: NetworkTypeTestnet}
@@ -253,7 +254,7 @@ var (
 	SONEIUM_MAINNET                                = Chain{EvmChainID: 1868, Selector: 12505351618335765396, Name: "soneium-mainnet", NetworkType: NetworkTypeMainnet}
 	SONIC_MAINNET                                  = Chain{EvmChainID: 146, Selector: 1673871237479749969, Name: "sonic-mainnet", NetworkType: NetworkTypeMainnet}
 	SONIC_TESTNET                                  = Chain{EvmChainID: 14601, Selector: 1763698235108410440, Name: "sonic-testnet", NetworkType: NetworkTypeTestnet}
-	SONIC_TESTNET_BLAZE                            = Chain{EvmChainID: 57054, Selector: 3676871237479449268, Name: "sonic-testnet-blaze", NetworkType: NetworkTypeTestnet}
+	SONIC_TESTNET_BLAZE                            = Chain{EvmChainID: 57054, Selector: 3676871237479449268, Name: "sonic-testnet-blaze", NetworkType: NetworkTypeTestnet, Deprecated: true}
 	STABLE_MAINNET                                 = Chain{EvmChainID: 988, Selector: 16978377838628290997, Name: "stable-mainnet", NetworkType: NetworkTypeMainnet}
 	STABLE_TESTNET                                 = Chain{EvmChainID: 2201, Selector: 11793402411494852765, Name: "stable-testnet", NetworkType: NetworkTypeTestnet}
 	STORY_TESTNET                                  = Chain{EvmChainID: 1513, Selector: 4237030917318060427, Name: "story-testnet", NetworkType: NetworkTypeTestnet}
@@ -264,12 +265,12 @@ var (
 	TELOS_EVM_MAINNET                              = Chain{EvmChainID: 40, Selector: 1477345371608778000, Name: "telos-evm-mainnet", NetworkType: NetworkTypeMainnet}
 	TELOS_EVM_TESTNET                              = Chain{EvmChainID: 41, Selector: 729797994450396300, Name: "telos-evm-testnet", NetworkType: NetworkTypeTestnet}
 	TEMPO_MAINNET                                  = Chain{EvmChainID: 4217, Selector: 7281642695469137430, Name: "tempo-mainnet", NetworkType: NetworkTypeMainnet}
-	TEMPO_TESTNET                                  = Chain{EvmChainID: 42429, Selector: 3963528237232804922, Name: "tempo-testnet", NetworkType: NetworkTypeTestnet}
+	TEMPO_TESTNET                                  = Chain{EvmChainID: 42429, Selector: 3963528237232804922, Name: "tempo-testnet", NetworkType: NetworkTypeTestnet, Deprecated: true}
 	TEMPO_TESTNET_MODERATO                         = Chain{EvmChainID: 42431, Selector: 8457817439310187923, Name: "tempo-testnet-moderato", NetworkType: NetworkTypeTestnet}
 	TEST_0G_MAINNET                                = Chain{EvmChainID: 16661, Selector: 4426351306075016396, Name: "0g-mainnet", NetworkType: NetworkTypeMainnet}
-	TEST_0G_TESTNET_GALILEO                        = Chain{EvmChainID: 16601, Selector: 2131427466778448014, Name: "0g-testnet-galileo", NetworkType: NetworkTypeTestnet}
+	TEST_0G_TESTNET_GALILEO                        = Chain{EvmChainID: 16601, Selector: 2131427466778448014, Name: "0g-testnet-galileo", NetworkType: NetworkTypeTestnet, Deprecated: true}
 	TEST_0G_TESTNET_GALILEO_1                      = Chain{EvmChainID: 16602, Selector: 6892437333620424805, Name: "0g-testnet-galileo-1", NetworkType: NetworkTypeTestnet}
-	TEST_0G_TESTNET_NEWTON                         = Chain{EvmChainID: 16600, Selector: 16088006396410204581, Name: "0g-testnet-newton", NetworkType: NetworkTypeTestnet}
+	TEST_0G_TESTNET_NEWTON                         = Chain{EvmChainID: 16600, Selector: 16088006396410204581, Name: "0g-testnet-newton", NetworkType: NetworkTypeTestnet, Deprecated: true}
 	TEST_1000                                      = Chain{EvmChainID: 1000, Selector: 11787463284727550157, Name: "1000", NetworkType: NetworkTypeTestnet}
 	TEST_1338                                      = Chain{EvmChainID: 1338, Selector: 2181150070347029680, Name: "1338", NetworkType: NetworkTypeTestnet}
 	TEST_76578                                     = Chain{EvmChainID: 76578, Selector: 781901677223027175, Name: "76578", NetworkType: NetworkTypeTestnet}
@@ -374,8 +375,8 @@ var (
 	TEST_90000099                                  = Chain{EvmChainID: 90000099, Selector: 7431973150957944526, Name: "90000099", NetworkType: NetworkTypeTestnet}
 	TEST_90000100                                  = Chain{EvmChainID: 90000100, Selector: 6875898693582952601, Name: "90000100", NetworkType: NetworkTypeTestnet}
 	TEST_98865                                     = Chain{EvmChainID: 98865, Selector: 3208172210661564830, Name: "98865", NetworkType: NetworkTypeTestnet}
-	TREASURE_MAINNET                               = Chain{EvmChainID: 61166, Selector: 5214452172935136222, Name: "treasure-mainnet", NetworkType: NetworkTypeMainnet}
-	TREASURE_TESTNET_TOPAZ                         = Chain{EvmChainID: 978658, Selector: 3676916124122457866, Name: "treasure-testnet-topaz", NetworkType: NetworkTypeTestnet}
+	TREASURE_MAINNET                               = Chain{EvmChainID: 61166, Selector: 5214452172935136222, Name: "treasure-mainnet", NetworkType: NetworkTypeMainnet, Deprecated: true}
+	TREASURE_TESTNET_TOPAZ                         = Chain{EvmChainID: 978658, Selector: 3676916124122457866, Name: "treasure-testnet-topaz", NetworkType: NetworkTypeTestnet, Deprecated: true}
 	TRON_DEVNET_EVM                                = Chain{EvmChainID: 3360022319, Selector: 13231703482326770600, Name: "tron-devnet-evm", NetworkType: NetworkTypeTestnet}
 	TRON_MAINNET_EVM                               = Chain{EvmChainID: 728126428, Selector: 1546563616611573946, Name: "tron-mainnet-evm", NetworkType: NetworkTypeMainnet}
 	TRON_TESTNET_NILE_EVM                          = Chain{EvmChainID: 3448148188, Selector: 2052925811360307749, Name: "tron-testnet-nile-evm", NetworkType: NetworkTypeTestnet}
@@ -388,7 +389,7 @@ var (
 	XDC_MAINNET                                    = Chain{EvmChainID: 50, Selector: 17673274061779414707, Name: "xdc-mainnet", NetworkType: NetworkTypeMainnet}
 	XDC_TESTNET                                    = Chain{EvmChainID: 51, Selector: 3017758115101368649, Name: "xdc-testnet", NetworkType: NetworkTypeTestnet}
 	XLAYER_TESTNET                                 = Chain{EvmChainID: 1952, Selector: 10212741611335999305, Name: "xlayer-testnet", NetworkType: NetworkTypeTestnet}
-	ZERO_G_TESTNET_GALILEO                         = Chain{EvmChainID: 80087, Selector: 2285225387454015855, Name: "zero-g-testnet-galileo", NetworkType: NetworkTypeTestnet}
+	ZERO_G_TESTNET_GALILEO                         = Chain{EvmChainID: 80087, Selector: 2285225387454015855, Name: "zero-g-testnet-galileo", NetworkType: NetworkTypeTestnet, Deprecated: true}
 	ZETACHAIN_MAINNET                              = Chain{EvmChainID: 7000, Selector: 10817664450262215148, Name: "zetachain-mainnet", NetworkType: NetworkTypeMainnet}
 	ZIRCUIT_TESTNET_GARFIELD                       = Chain{EvmChainID: 48898, Selector: 13781831279385219069, Name: "zircuit-testnet-garfield", NetworkType: NetworkTypeTestnet}
 	ZKLINK_NOVA_MAINNET                            = Chain{EvmChainID: 810180, Selector: 4350319965322101699, Name: "zklink_nova-mainnet", NetworkType: NetworkTypeMainnet}
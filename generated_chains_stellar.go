@@ -6,6 +6,7 @@ type StellarChain struct {
 	Selector    uint64
 	Name        string
 	NetworkType NetworkType
+	Deprecated  bool
 	Passphrase  string
 }
 
@@ -0,0 +1,491 @@
+// Code generated by go generate please DO NOT EDIT
+package chain_selectors
+
+// embeddedChains holds the chains of every selectors file embedded in this package.
+var embeddedChains = [][]chainEntry{
+	evmSelectors,
+	evmTestSelectors,
+	solanaSelectors,
+	solanaTestSelectors,
+	aptosSelectors,
+	suiSelectors,
+	tonSelectors,
+	tronSelectors,
+	starknetSelectors,
+	cantonSelectors,
+	stellarSelectors,
+	cosmosSelectors,
+}
+
+// evmSelectors holds the chains of selectors.yml.
+var evmSelectors = []chainEntry{
+	{Family: FamilyEVM, ChainID: "1", ChainDetails: ChainDetails{ChainSelector: 5009297550715157269, ChainName: "ethereum-mainnet", NetworkType: NetworkTypeMainnet}},
+	{Family: FamilyEVM, ChainID: "10", ChainDetails: ChainDetails{ChainSelector: 3734403246176062136, ChainName: "ethereum-mainnet-optimism-1", NetworkType: NetworkTypeMainnet}},
+	{Family: FamilyEVM, ChainID: "25", ChainDetails: ChainDetails{ChainSelector: 1456215246176062136, ChainName: "cronos-mainnet", NetworkType: NetworkTypeMainnet}},
+	{Family: FamilyEVM, ChainID: "30", ChainDetails: ChainDetails{ChainSelector: 11964252391146578476, ChainName: "rootstock-mainnet", NetworkType: NetworkTypeMainnet}},
+	{Family: FamilyEVM, ChainID: "31", ChainDetails: ChainDetails{ChainSelector: 8953668971247136127, ChainName: "bitcoin-testnet-rootstock", NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "40", ChainDetails: ChainDetails{ChainSelector: 1477345371608778000, ChainName: "telos-evm-mainnet", NetworkType: NetworkTypeMainnet}},
+	{Family: FamilyEVM, ChainID: "41", ChainDetails: ChainDetails{ChainSelector: 729797994450396300, ChainName: "telos-evm-testnet", NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "45", ChainDetails: ChainDetails{ChainSelector: 4340886533089894000, ChainName: "polkadot-testnet-darwinia-pangoro", NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "46", ChainDetails: ChainDetails{ChainSelector: 8866418665544333000, ChainName: "polkadot-mainnet-darwinia", NetworkType: NetworkTypeMainnet}},
+	{Family: FamilyEVM, ChainID: "50", ChainDetails: ChainDetails{ChainSelector: 17673274061779414707, ChainName: "xdc-mainnet", NetworkType: NetworkTypeMainnet}},
+	{Family: FamilyEVM, ChainID: "51", ChainDetails: ChainDetails{ChainSelector: 3017758115101368649, ChainName: "xdc-testnet", NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "52", ChainDetails: ChainDetails{ChainSelector: 1761333065194157300, ChainName: "coinex_smart_chain-mainnet", NetworkType: NetworkTypeMainnet}},
+	{Family: FamilyEVM, ChainID: "53", ChainDetails: ChainDetails{ChainSelector: 8955032871639343000, ChainName: "coinex_smart_chain-testnet", NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "56", ChainDetails: ChainDetails{ChainSelector: 11344663589394136015, ChainName: "binance_smart_chain-mainnet", NetworkType: NetworkTypeMainnet}},
+	{Family: FamilyEVM, ChainID: "81", ChainDetails: ChainDetails{ChainSelector: 6955638871347136141, ChainName: "polkadot-testnet-astar-shibuya", NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "85", ChainDetails: ChainDetails{ChainSelector: 3558960680482140165, ChainName: "gate-chain-testnet-meteora", NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "86", ChainDetails: ChainDetails{ChainSelector: 9688382747979139404, ChainName: "gate-chain-mainnet", NetworkType: NetworkTypeMainnet}},
+	{Family: FamilyEVM, ChainID: "97", ChainDetails: ChainDetails{ChainSelector: 13264668187771770619, ChainName: "binance_smart_chain-testnet", NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "100", ChainDetails: ChainDetails{ChainSelector: 465200170687744372, ChainName: "gnosis_chain-mainnet", NetworkType: NetworkTypeMainnet}},
+	{Family: FamilyEVM, ChainID: "106", ChainDetails: ChainDetails{ChainSelector: 374210358663784372, ChainName: "velas-mainnet", NetworkType: NetworkTypeMainnet}},
+	{Family: FamilyEVM, ChainID: "109", ChainDetails: ChainDetails{ChainSelector: 3993510008929295315, ChainName: "shibarium-mainnet", NetworkType: NetworkTypeMainnet}},
+	{Family: FamilyEVM, ChainID: "111", ChainDetails: ChainDetails{ChainSelector: 572210378683744374, ChainName: "velas-testnet", NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "130", ChainDetails: ChainDetails{ChainSelector: 1923510103922296319, ChainName: "ethereum-mainnet-unichain-1", NetworkType: NetworkTypeMainnet}},
+	{Family: FamilyEVM, ChainID: "133", ChainDetails: ChainDetails{ChainSelector: 4356164186791070119, ChainName: "ethereum-testnet-sepolia-hashkey-1", NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "137", ChainDetails: ChainDetails{ChainSelector: 4051577828743386545, ChainName: "polygon-mainnet", NetworkType: NetworkTypeMainnet}},
+	{Family: FamilyEVM, ChainID: "143", ChainDetails: ChainDetails{ChainSelector: 8481857512324358265, ChainName: "monad-mainnet", NetworkType: NetworkTypeMainnet}},
+	{Family: FamilyEVM, ChainID: "146", ChainDetails: ChainDetails{ChainSelector: 1673871237479749969, ChainName: "sonic-mainnet", NetworkType: NetworkTypeMainnet}},
+	{Family: FamilyEVM, ChainID: "157", ChainDetails: ChainDetails{ChainSelector: 17833296867764334567, ChainName: "shibarium-testnet-puppynet", NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "177", ChainDetails: ChainDetails{ChainSelector: 7613811247471741961, ChainName: "ethereum-mainnet-hashkey-1", NetworkType: NetworkTypeMainnet}},
+	{Family: FamilyEVM, ChainID: "185", ChainDetails: ChainDetails{ChainSelector: 17164792800244661392, ChainName: "mint-mainnet", NetworkType: NetworkTypeMainnet, Deprecated: true}},
+	{Family: FamilyEVM, ChainID: "195", ChainDetails: ChainDetails{ChainSelector: 2066098519157881736, ChainName: "ethereum-testnet-sepolia-xlayer-1", NetworkType: NetworkTypeTestnet, Deprecated: true}},
+	{Family: FamilyEVM, ChainID: "196", ChainDetails: ChainDetails{ChainSelector: 3016212468291539606, ChainName: "ethereum-mainnet-xlayer-1", NetworkType: NetworkTypeMainnet}},
+	{Family: FamilyEVM, ChainID: "199", ChainDetails: ChainDetails{ChainSelector: 3776006016387883143, ChainName: "bittorrent_chain-mainnet", NetworkType: NetworkTypeMainnet}},
+	{Family: FamilyEVM, ChainID: "204", ChainDetails: ChainDetails{ChainSelector: 465944652040885897, ChainName: "binance_smart_chain-mainnet-opbnb-1", NetworkType: NetworkTypeMainnet}},
+	{Family: FamilyEVM, ChainID: "223", ChainDetails: ChainDetails{ChainSelector: 5406759801798337480, ChainName: "bitcoin-mainnet-bsquared-1", NetworkType: NetworkTypeMainnet}},
+	{Family: FamilyEVM, ChainID: "228", ChainDetails: ChainDetails{ChainSelector: 11690709103138290329, ChainName: "mind-mainnet", NetworkType: NetworkTypeMainnet, Deprecated: true}},
+	{Family: FamilyEVM, ChainID: "232", ChainDetails: ChainDetails{ChainSelector: 5608378062013572713, ChainName: "lens-mainnet", NetworkType: NetworkTypeMainnet}},
+	{Family: FamilyEVM, ChainID: "239", ChainDetails: ChainDetails{ChainSelector: 5936861837188149645, ChainName: "tac-mainnet", NetworkType: NetworkTypeMainnet}},
+	{Family: FamilyEVM, ChainID: "240", ChainDetails: ChainDetails{ChainSelector: 16487132492576884721, ChainName: "cronos-zkevm-testnet-sepolia", NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "250", ChainDetails: ChainDetails{ChainSelector: 3768048213127883732, ChainName: "fantom-mainnet", NetworkType: NetworkTypeMainnet}},
+	{Family: FamilyEVM, ChainID: "252", ChainDetails: ChainDetails{ChainSelector: 1462016016387883143, ChainName: "fraxtal-mainnet", NetworkType: NetworkTypeMainnet}},
+	{Family: FamilyEVM, ChainID: "255", ChainDetails: ChainDetails{ChainSelector: 3719320017875267166, ChainName: "ethereum-mainnet-kroma-1", NetworkType: NetworkTypeMainnet, Deprecated: true}},
+	{Family: FamilyEVM, ChainID: "259", ChainDetails: ChainDetails{ChainSelector: 8239338020728974000, ChainName: "neonlink-mainnet", NetworkType: NetworkTypeMainnet}},
+	{Family: FamilyEVM, ChainID: "280", ChainDetails: ChainDetails{ChainSelector: 6802309497652714138, ChainName: "ethereum-testnet-goerli-zksync-1", NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "282", ChainDetails: ChainDetails{ChainSelector: 3842103497652714138, ChainName: "cronos-testnet-zkevm-1", NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "295", ChainDetails: ChainDetails{ChainSelector: 3229138320728879060, ChainName: "hedera-mainnet", NetworkType: NetworkTypeMainnet}},
+	{Family: FamilyEVM, ChainID: "296", ChainDetails: ChainDetails{ChainSelector: 222782988166878823, ChainName: "hedera-testnet", NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "300", ChainDetails: ChainDetails{ChainSelector: 6898391096552792247, ChainName: "ethereum-testnet-sepolia-zksync-1", NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "314", ChainDetails: ChainDetails{ChainSelector: 4561443241176882990, ChainName: "filecoin-mainnet", NetworkType: NetworkTypeMainnet}},
+	{Family: FamilyEVM, ChainID: "324", ChainDetails: ChainDetails{ChainSelector: 1562403441176082196, ChainName: "ethereum-mainnet-zksync-1", NetworkType: NetworkTypeMainnet}},
+	{Family: FamilyEVM, ChainID: "338", ChainDetails: ChainDetails{ChainSelector: 2995292832068775165, ChainName: "cronos-testnet", NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "388", ChainDetails: ChainDetails{ChainSelector: 8788096068760390840, ChainName: "cronos-zkevm-mainnet", NetworkType: NetworkTypeMainnet}},
+	{Family: FamilyEVM, ChainID: "397", ChainDetails: ChainDetails{ChainSelector: 2039744413822257700, ChainName: "near-mainnet", NetworkType: NetworkTypeMainnet}},
+	{Family: FamilyEVM, ChainID: "398", ChainDetails: ChainDetails{ChainSelector: 5061593697262339000, ChainName: "near-testnet", NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "420", ChainDetails: ChainDetails{ChainSelector: 2664363617261496610, ChainName: "ethereum-testnet-goerli-optimism-1", NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "462", ChainDetails: ChainDetails{ChainSelector: 7317911323415911000, ChainName: "areon-testnet", NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "463", ChainDetails: ChainDetails{ChainSelector: 1939936305787790600, ChainName: "areon-mainnet", NetworkType: NetworkTypeMainnet}},
+	{Family: FamilyEVM, ChainID: "480", ChainDetails: ChainDetails{ChainSelector: 2049429975587534727, ChainName: "ethereum-mainnet-worldchain-1", NetworkType: NetworkTypeMainnet}},
+	{Family: FamilyEVM, ChainID: "592", ChainDetails: ChainDetails{ChainSelector: 6422105447186081193, ChainName: "polkadot-mainnet-astar", NetworkType: NetworkTypeMainnet}},
+	{Family: FamilyEVM, ChainID: "678", ChainDetails: ChainDetails{ChainSelector: 9107126442626377432, ChainName: "janction-mainnet", NetworkType: NetworkTypeMainnet}},
+	{Family: FamilyEVM, ChainID: "679", ChainDetails: ChainDetails{ChainSelector: 5059197667603797935, ChainName: "janction-testnet-sepolia", NetworkType: NetworkTypeTestnet, Deprecated: true}},
+	{Family: FamilyEVM, ChainID: "682", ChainDetails: ChainDetails{ChainSelector: 6260932437388305511, ChainName: "private-testnet-obsidian", NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "919", ChainDetails: ChainDetails{ChainSelector: 829525985033418733, ChainName: "ethereum-testnet-sepolia-mode-1", NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "945", ChainDetails: ChainDetails{ChainSelector: 2177900824115119161, ChainName: "bittensor-testnet", NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "964", ChainDetails: ChainDetails{ChainSelector: 2135107236357186872, ChainName: "bittensor-mainnet", NetworkType: NetworkTypeMainnet}},
+	{Family: FamilyEVM, ChainID: "988", ChainDetails: ChainDetails{ChainSelector: 16978377838628290997, ChainName: "stable-mainnet", NetworkType: NetworkTypeMainnet}},
+	{Family: FamilyEVM, ChainID: "998", ChainDetails: ChainDetails{ChainSelector: 4286062357653186312, ChainName: "hyperliquid-testnet", NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "999", ChainDetails: ChainDetails{ChainSelector: 2442541497099098535, ChainName: "hyperliquid-mainnet", NetworkType: NetworkTypeMainnet}},
+	{Family: FamilyEVM, ChainID: "1001", ChainDetails: ChainDetails{ChainSelector: 2624132734533621656, ChainName: "kaia-testnet-kairos", NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "1029", ChainDetails: ChainDetails{ChainSelector: 4459371029167934217, ChainName: "bittorrent_chain-testnet", NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "1030", ChainDetails: ChainDetails{ChainSelector: 3358365939762719202, ChainName: "conflux-mainnet", NetworkType: NetworkTypeMainnet}},
+	{Family: FamilyEVM, ChainID: "1088", ChainDetails: ChainDetails{ChainSelector: 8805746078405598895, ChainName: "ethereum-mainnet-metis-1", NetworkType: NetworkTypeMainnet}},
+	{Family: FamilyEVM, ChainID: "1101", ChainDetails: ChainDetails{ChainSelector: 4348158687435793198, ChainName: "ethereum-mainnet-polygon-zkevm-1", NetworkType: NetworkTypeMainnet, Deprecated: true}},
+	{Family: FamilyEVM, ChainID: "1111", ChainDetails: ChainDetails{ChainSelector: 5142893604156789321, ChainName: "wemix-mainnet", NetworkType: NetworkTypeMainnet}},
+	{Family: FamilyEVM, ChainID: "1112", ChainDetails: ChainDetails{ChainSelector: 9284632837123596123, ChainName: "wemix-testnet", NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "1114", ChainDetails: ChainDetails{ChainSelector: 4264732132125536123, ChainName: "core-testnet", NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "1116", ChainDetails: ChainDetails{ChainSelector: 1224752112135636129, ChainName: "core-mainnet", NetworkType: NetworkTypeMainnet}},
+	{Family: FamilyEVM, ChainID: "1123", ChainDetails: ChainDetails{ChainSelector: 1948510578179542068, ChainName: "bitcoin-testnet-bsquared-1", NetworkType: NetworkTypeTestnet, Deprecated: true}},
+	{Family: FamilyEVM, ChainID: "1135", ChainDetails: ChainDetails{ChainSelector: 15293031020466096408, ChainName: "lisk-mainnet", NetworkType: NetworkTypeMainnet}},
+	{Family: FamilyEVM, ChainID: "1284", ChainDetails: ChainDetails{ChainSelector: 1252863800116739621, ChainName: "polkadot-mainnet-moonbeam", NetworkType: NetworkTypeMainnet}},
+	{Family: FamilyEVM, ChainID: "1285", ChainDetails: ChainDetails{ChainSelector: 1355020143337428062, ChainName: "kusama-mainnet-moonriver", NetworkType: NetworkTypeMainnet}},
+	{Family: FamilyEVM, ChainID: "1287", ChainDetails: ChainDetails{ChainSelector: 5361632739113536121, ChainName: "polkadot-testnet-moonbeam-moonbase", NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "1301", ChainDetails: ChainDetails{ChainSelector: 14135854469784514356, ChainName: "ethereum-testnet-sepolia-unichain-1", NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "1328", ChainDetails: ChainDetails{ChainSelector: 1216300075444106652, ChainName: "sei-testnet-atlantic", NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "1329", ChainDetails: ChainDetails{ChainSelector: 9027416829622342829, ChainName: "sei-mainnet", NetworkType: NetworkTypeMainnet}},
+	{Family: FamilyEVM, ChainID: "1337", ChainDetails: ChainDetails{ChainSelector: 3379446385462418246, ChainName: "geth-testnet", NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "1338", ChainDetails: ChainDetails{ChainSelector: 2181150070347029680, NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "1442", ChainDetails: ChainDetails{ChainSelector: 11059667695644972511, ChainName: "ethereum-testnet-goerli-polygon-zkevm-1", NetworkType: NetworkTypeTestnet, Deprecated: true}},
+	{Family: FamilyEVM, ChainID: "1513", ChainDetails: ChainDetails{ChainSelector: 4237030917318060427, ChainName: "story-testnet", NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "1672", ChainDetails: ChainDetails{ChainSelector: 7801139999541420232, ChainName: "pharos-mainnet", NetworkType: NetworkTypeMainnet}},
+	{Family: FamilyEVM, ChainID: "1687", ChainDetails: ChainDetails{ChainSelector: 10749384167430721561, ChainName: "mint-testnet", NetworkType: NetworkTypeTestnet, Deprecated: true}},
+	{Family: FamilyEVM, ChainID: "1740", ChainDetails: ChainDetails{ChainSelector: 6286293440461807648, ChainName: "metal-testnet", NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "1750", ChainDetails: ChainDetails{ChainSelector: 13447077090413146373, ChainName: "metal-mainnet", NetworkType: NetworkTypeMainnet}},
+	{Family: FamilyEVM, ChainID: "1868", ChainDetails: ChainDetails{ChainSelector: 12505351618335765396, ChainName: "soneium-mainnet", NetworkType: NetworkTypeMainnet}},
+	{Family: FamilyEVM, ChainID: "1907", ChainDetails: ChainDetails{ChainSelector: 4874388048629246000, ChainName: "bitcichain-mainnet", NetworkType: NetworkTypeMainnet}},
+	{Family: FamilyEVM, ChainID: "1908", ChainDetails: ChainDetails{ChainSelector: 4888058894222120000, ChainName: "bitcichain-testnet", NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "1946", ChainDetails: ChainDetails{ChainSelector: 686603546605904534, ChainName: "ethereum-testnet-sepolia-soneium-1", NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "1952", ChainDetails: ChainDetails{ChainSelector: 10212741611335999305, ChainName: "xlayer-testnet", NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "2020", ChainDetails: ChainDetails{ChainSelector: 6916147374840168594, ChainName: "ronin-mainnet", NetworkType: NetworkTypeMainnet}},
+	{Family: FamilyEVM, ChainID: "2021", ChainDetails: ChainDetails{ChainSelector: 13116810400804392105, ChainName: "ronin-testnet-saigon", NetworkType: NetworkTypeTestnet, Deprecated: true}},
+	{Family: FamilyEVM, ChainID: "2023", ChainDetails: ChainDetails{ChainSelector: 3260900564719373474, ChainName: "private-testnet-granite", NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "2024", ChainDetails: ChainDetails{ChainSelector: 6915682381028791124, ChainName: "private-testnet-andesite", NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "2025", ChainDetails: ChainDetails{ChainSelector: 15513093881969820114, ChainName: "dtcc-testnet-andesite", NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "2031", ChainDetails: ChainDetails{ChainSelector: 8175830712062617656, ChainName: "polkadot-mainnet-centrifuge", NetworkType: NetworkTypeMainnet}},
+	{Family: FamilyEVM, ChainID: "2088", ChainDetails: ChainDetails{ChainSelector: 2333097300889804761, ChainName: "polkadot-testnet-centrifuge-altair", NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "2129", ChainDetails: ChainDetails{ChainSelector: 12168171414969487009, ChainName: "memento-testnet", NetworkType: NetworkTypeTestnet, Deprecated: true}},
+	{Family: FamilyEVM, ChainID: "2201", ChainDetails: ChainDetails{ChainSelector: 11793402411494852765, ChainName: "stable-testnet", NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "2221", ChainDetails: ChainDetails{ChainSelector: 2110537777356199208, ChainName: "kava-testnet", NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "2222", ChainDetails: ChainDetails{ChainSelector: 7550000543357438061, ChainName: "kava-mainnet", NetworkType: NetworkTypeMainnet}},
+	{Family: FamilyEVM, ChainID: "2358", ChainDetails: ChainDetails{ChainSelector: 5990477251245693094, ChainName: "ethereum-testnet-sepolia-kroma-1", NetworkType: NetworkTypeTestnet, Deprecated: true}},
+	{Family: FamilyEVM, ChainID: "2391", ChainDetails: ChainDetails{ChainSelector: 9488606126177218005, ChainName: "tac-testnet", NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "2442", ChainDetails: ChainDetails{ChainSelector: 1654667687261492630, ChainName: "ethereum-testnet-sepolia-polygon-zkevm-1", NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "2522", ChainDetails: ChainDetails{ChainSelector: 8901520481741771655, ChainName: "ethereum-testnet-holesky-fraxtal-1", NetworkType: NetworkTypeTestnet, Deprecated: true}},
+	{Family: FamilyEVM, ChainID: "2741", ChainDetails: ChainDetails{ChainSelector: 3577778157919314504, ChainName: "abstract-mainnet", NetworkType: NetworkTypeMainnet}},
+	{Family: FamilyEVM, ChainID: "2810", ChainDetails: ChainDetails{ChainSelector: 8304510386741731151, ChainName: "ethereum-testnet-holesky-morph-1", NetworkType: NetworkTypeTestnet, Deprecated: true}},
+	{Family: FamilyEVM, ChainID: "2818", ChainDetails: ChainDetails{ChainSelector: 18164309074156128038, ChainName: "morph-mainnet", NetworkType: NetworkTypeMainnet}},
+	{Family: FamilyEVM, ChainID: "2910", ChainDetails: ChainDetails{ChainSelector: 1064004874793747259, ChainName: "ethereum-testnet-hoodi-morph", NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "3343", ChainDetails: ChainDetails{ChainSelector: 6325494908023253251, ChainName: "edge-mainnet", NetworkType: NetworkTypeMainnet}},
+	{Family: FamilyEVM, ChainID: "3636", ChainDetails: ChainDetails{ChainSelector: 1467223411771711614, ChainName: "bitcoin-testnet-botanix", NetworkType: NetworkTypeTestnet, Deprecated: true}},
+	{Family: FamilyEVM, ChainID: "3637", ChainDetails: ChainDetails{ChainSelector: 4560701533377838164, ChainName: "bitcoin-mainnet-botanix", NetworkType: NetworkTypeMainnet, Deprecated: true}},
+	{Family: FamilyEVM, ChainID: "3776", ChainDetails: ChainDetails{ChainSelector: 1540201334317828111, ChainName: "ethereum-mainnet-astar-zkevm-1", NetworkType: NetworkTypeMainnet}},
+	{Family: FamilyEVM, ChainID: "4002", ChainDetails: ChainDetails{ChainSelector: 4905564228793744293, ChainName: "fantom-testnet", NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "4200", ChainDetails: ChainDetails{ChainSelector: 241851231317828981, ChainName: "bitcoin-merlin-mainnet", NetworkType: NetworkTypeMainnet}},
+	{Family: FamilyEVM, ChainID: "4202", ChainDetails: ChainDetails{ChainSelector: 5298399861320400553, ChainName: "ethereum-testnet-sepolia-lisk-1", NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "4217", ChainDetails: ChainDetails{ChainSelector: 7281642695469137430, ChainName: "tempo-mainnet", NetworkType: NetworkTypeMainnet}},
+	{Family: FamilyEVM, ChainID: "4326", ChainDetails: ChainDetails{ChainSelector: 6093540873831549674, ChainName: "megaeth-mainnet", NetworkType: NetworkTypeMainnet}},
+	{Family: FamilyEVM, ChainID: "4663", ChainDetails: ChainDetails{ChainSelector: 6180753054346818345, ChainName: "robinhood-mainnet", NetworkType: NetworkTypeMainnet}},
+	{Family: FamilyEVM, ChainID: "4801", ChainDetails: ChainDetails{ChainSelector: 5299555114858065850, ChainName: "ethereum-testnet-sepolia-worldchain-1", NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "5000", ChainDetails: ChainDetails{ChainSelector: 1556008542357238666, ChainName: "ethereum-mainnet-mantle-1", NetworkType: NetworkTypeMainnet}},
+	{Family: FamilyEVM, ChainID: "5001", ChainDetails: ChainDetails{ChainSelector: 4168263376276232250, ChainName: "ethereum-testnet-goerli-mantle-1", NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "5003", ChainDetails: ChainDetails{ChainSelector: 8236463271206331221, ChainName: "ethereum-testnet-sepolia-mantle-1", NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "5042", ChainDetails: ChainDetails{ChainSelector: 6370580034781731079, ChainName: "arc-mainnet", NetworkType: NetworkTypeMainnet}},
+	{Family: FamilyEVM, ChainID: "5330", ChainDetails: ChainDetails{ChainSelector: 470401360549526817, ChainName: "superseed-mainnet", NetworkType: NetworkTypeMainnet}},
+	{Family: FamilyEVM, ChainID: "5611", ChainDetails: ChainDetails{ChainSelector: 13274425992935471758, ChainName: "binance_smart_chain-testnet-opbnb-1", NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "5668", ChainDetails: ChainDetails{ChainSelector: 8911150974185440581, ChainName: "nexon-dev", NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "6342", ChainDetails: ChainDetails{ChainSelector: 2443239559770384419, ChainName: "megaeth-testnet", NetworkType: NetworkTypeTestnet, Deprecated: true}},
+	{Family: FamilyEVM, ChainID: "6343", ChainDetails: ChainDetails{ChainSelector: 18241817625092392675, ChainName: "megaeth-testnet-2", NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "6398", ChainDetails: ChainDetails{ChainSelector: 379340054879810246, ChainName: "everclear-testnet-sepolia", NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "6900", ChainDetails: ChainDetails{ChainSelector: 17349189558768828726, ChainName: "nibiru-mainnet", NetworkType: NetworkTypeMainnet}},
+	{Family: FamilyEVM, ChainID: "6930", ChainDetails: ChainDetails{ChainSelector: 305104239123120457, ChainName: "nibiru-testnet", NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "7000", ChainDetails: ChainDetails{ChainSelector: 10817664450262215148, ChainName: "zetachain-mainnet", NetworkType: NetworkTypeMainnet}},
+	{Family: FamilyEVM, ChainID: "8217", ChainDetails: ChainDetails{ChainSelector: 9813823125703490621, ChainName: "kaia-mainnet", NetworkType: NetworkTypeMainnet}},
+	{Family: FamilyEVM, ChainID: "8453", ChainDetails: ChainDetails{ChainSelector: 15971525489660198786, ChainName: "ethereum-mainnet-base-1", NetworkType: NetworkTypeMainnet}},
+	{Family: FamilyEVM, ChainID: "9000", ChainDetails: ChainDetails{ChainSelector: 344208382356656551, ChainName: "ondo-testnet", NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "9559", ChainDetails: ChainDetails{ChainSelector: 1113014352258747600, ChainName: "neonlink-testnet", NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "9745", ChainDetails: ChainDetails{ChainSelector: 9335212494177455608, ChainName: "plasma-mainnet", NetworkType: NetworkTypeMainnet}},
+	{Family: FamilyEVM, ChainID: "9746", ChainDetails: ChainDetails{ChainSelector: 3967220077692964309, ChainName: "plasma-testnet", NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "10087", ChainDetails: ChainDetails{ChainSelector: 3667207123485082040, ChainName: "gate-layer-testnet", NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "10088", ChainDetails: ChainDetails{ChainSelector: 9373518659714509671, ChainName: "gate-layer-mainnet", NetworkType: NetworkTypeMainnet}},
+	{Family: FamilyEVM, ChainID: "10143", ChainDetails: ChainDetails{ChainSelector: 2183018362218727504, ChainName: "monad-testnet", NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "10200", ChainDetails: ChainDetails{ChainSelector: 8871595565390010547, ChainName: "gnosis_chain-testnet-chiado", NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "10323", ChainDetails: ChainDetails{ChainSelector: 9211758560309513668, ChainName: "mova-testnet", NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "11124", ChainDetails: ChainDetails{ChainSelector: 16235373811196386733, ChainName: "abstract-testnet", NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "12324", ChainDetails: ChainDetails{ChainSelector: 3162193654116181371, ChainName: "ethereum-mainnet-arbitrum-1-l3x-1", NetworkType: NetworkTypeMainnet}},
+	{Family: FamilyEVM, ChainID: "12325", ChainDetails: ChainDetails{ChainSelector: 3486622437121596122, ChainName: "ethereum-testnet-sepolia-arbitrum-1-l3x-1", NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "13371", ChainDetails: ChainDetails{ChainSelector: 1237925231416731909, ChainName: "ethereum-mainnet-immutable-zkevm-1", NetworkType: NetworkTypeMainnet}},
+	{Family: FamilyEVM, ChainID: "13473", ChainDetails: ChainDetails{ChainSelector: 4526165231216331901, ChainName: "ethereum-testnet-sepolia-immutable-zkevm-1", NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "14601", ChainDetails: ChainDetails{ChainSelector: 1763698235108410440, ChainName: "sonic-testnet", NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "16600", ChainDetails: ChainDetails{ChainSelector: 16088006396410204581, ChainName: "0g-testnet-newton", NetworkType: NetworkTypeTestnet, Deprecated: true}},
+	{Family: FamilyEVM, ChainID: "16601", ChainDetails: ChainDetails{ChainSelector: 2131427466778448014, ChainName: "0g-testnet-galileo", NetworkType: NetworkTypeTestnet, Deprecated: true}},
+	{Family: FamilyEVM, ChainID: "16602", ChainDetails: ChainDetails{ChainSelector: 6892437333620424805, ChainName: "0g-testnet-galileo-1", NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "16661", ChainDetails: ChainDetails{ChainSelector: 4426351306075016396, ChainName: "0g-mainnet", NetworkType: NetworkTypeMainnet}},
+	{Family: FamilyEVM, ChainID: "17000", ChainDetails: ChainDetails{ChainSelector: 7717148896336251131, ChainName: "ethereum-testnet-holesky", NetworkType: NetworkTypeTestnet, Deprecated: true}},
+	{Family: FamilyEVM, ChainID: "25327", ChainDetails: ChainDetails{ChainSelector: 9723842205701363942, ChainName: "everclear-mainnet", NetworkType: NetworkTypeMainnet, Deprecated: true}},
+	{Family: FamilyEVM, ChainID: "26888", ChainDetails: ChainDetails{ChainSelector: 7051849327615092843, ChainName: "ab-testnet", NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "31337", ChainDetails: ChainDetails{ChainSelector: 7759470850252068959, ChainName: "anvil-devnet", NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "33111", ChainDetails: ChainDetails{ChainSelector: 9900119385908781505, ChainName: "apechain-testnet-curtis", NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "33139", ChainDetails: ChainDetails{ChainSelector: 14894068710063348487, ChainName: "apechain-mainnet", NetworkType: NetworkTypeMainnet}},
+	{Family: FamilyEVM, ChainID: "33431", ChainDetails: ChainDetails{ChainSelector: 13222148116102326311, ChainName: "edge-testnet", NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "34443", ChainDetails: ChainDetails{ChainSelector: 7264351850409363825, ChainName: "ethereum-mainnet-mode-1", NetworkType: NetworkTypeMainnet}},
+	{Family: FamilyEVM, ChainID: "36888", ChainDetails: ChainDetails{ChainSelector: 4829375610284793157, ChainName: "ab-mainnet", NetworkType: NetworkTypeMainnet}},
+	{Family: FamilyEVM, ChainID: "36900", ChainDetails: ChainDetails{ChainSelector: 4059281736450291836, ChainName: "adi-mainnet", NetworkType: NetworkTypeMainnet}},
+	{Family: FamilyEVM, ChainID: "37111", ChainDetails: ChainDetails{ChainSelector: 6827576821754315911, ChainName: "ethereum-testnet-sepolia-lens-1", NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "42161", ChainDetails: ChainDetails{ChainSelector: 4949039107694359620, ChainName: "ethereum-mainnet-arbitrum-1", NetworkType: NetworkTypeMainnet}},
+	{Family: FamilyEVM, ChainID: "42220", ChainDetails: ChainDetails{ChainSelector: 1346049177634351622, ChainName: "celo-mainnet", NetworkType: NetworkTypeMainnet}},
+	{Family: FamilyEVM, ChainID: "42429", ChainDetails: ChainDetails{ChainSelector: 3963528237232804922, ChainName: "tempo-testnet", NetworkType: NetworkTypeTestnet, Deprecated: true}},
+	{Family: FamilyEVM, ChainID: "42431", ChainDetails: ChainDetails{ChainSelector: 8457817439310187923, ChainName: "tempo-testnet-moderato", NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "42793", ChainDetails: ChainDetails{ChainSelector: 13624601974233774587, ChainName: "etherlink-mainnet", NetworkType: NetworkTypeMainnet}},
+	{Family: FamilyEVM, ChainID: "43111", ChainDetails: ChainDetails{ChainSelector: 1804312132722180201, ChainName: "hemi-mainnet", NetworkType: NetworkTypeMainnet}},
+	{Family: FamilyEVM, ChainID: "43113", ChainDetails: ChainDetails{ChainSelector: 14767482510784806043, ChainName: "avalanche-testnet-fuji", NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "43114", ChainDetails: ChainDetails{ChainSelector: 6433500567565415381, ChainName: "avalanche-mainnet", NetworkType: NetworkTypeMainnet}},
+	{Family: FamilyEVM, ChainID: "44787", ChainDetails: ChainDetails{ChainSelector: 3552045678561919002, ChainName: "celo-testnet-alfajores", NetworkType: NetworkTypeTestnet, Deprecated: true}},
+	{Family: FamilyEVM, ChainID: "45439", ChainDetails: ChainDetails{ChainSelector: 8446413392851542429, ChainName: "private-testnet-opala", NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "46630", ChainDetails: ChainDetails{ChainSelector: 2032988798112970440, ChainName: "robinhood-testnet", NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "47763", ChainDetails: ChainDetails{ChainSelector: 7222032299962346917, ChainName: "neox-mainnet", NetworkType: NetworkTypeMainnet}},
+	{Family: FamilyEVM, ChainID: "48898", ChainDetails: ChainDetails{ChainSelector: 13781831279385219069, ChainName: "zircuit-testnet-garfield", NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "48899", ChainDetails: ChainDetails{ChainSelector: 4562743618362911021, ChainName: "ethereum-testnet-sepolia-zircuit-1", NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "48900", ChainDetails: ChainDetails{ChainSelector: 17198166215261833993, ChainName: "ethereum-mainnet-zircuit-1", NetworkType: NetworkTypeMainnet}},
+	{Family: FamilyEVM, ChainID: "51888", ChainDetails: ChainDetails{ChainSelector: 6473245816409426016, ChainName: "memento-mainnet", NetworkType: NetworkTypeMainnet, Deprecated: true}},
+	{Family: FamilyEVM, ChainID: "53302", ChainDetails: ChainDetails{ChainSelector: 13694007683517087973, ChainName: "superseed-testnet", NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "57054", ChainDetails: ChainDetails{ChainSelector: 3676871237479449268, ChainName: "sonic-testnet-blaze", NetworkType: NetworkTypeTestnet, Deprecated: true}},
+	{Family: FamilyEVM, ChainID: "57073", ChainDetails: ChainDetails{ChainSelector: 3461204551265785888, ChainName: "ethereum-mainnet-ink-1", NetworkType: NetworkTypeMainnet}},
+	{Family: FamilyEVM, ChainID: "59140", ChainDetails: ChainDetails{ChainSelector: 1355246678561316402, ChainName: "ethereum-testnet-goerli-linea-1", NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "59141", ChainDetails: ChainDetails{ChainSelector: 5719461335882077547, ChainName: "ethereum-testnet-sepolia-linea-1", NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "59144", ChainDetails: ChainDetails{ChainSelector: 4627098889531055414, ChainName: "ethereum-mainnet-linea-1", NetworkType: NetworkTypeMainnet}},
+	{Family: FamilyEVM, ChainID: "59902", ChainDetails: ChainDetails{ChainSelector: 3777822886988675105, ChainName: "ethereum-testnet-sepolia-metis-1", NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "60118", ChainDetails: ChainDetails{ChainSelector: 15758750456714168963, ChainName: "nexon-mainnet-lith", NetworkType: NetworkTypeMainnet}},
+	{Family: FamilyEVM, ChainID: "60808", ChainDetails: ChainDetails{ChainSelector: 3849287863852499584, ChainName: "bitcoin-mainnet-bob-1", NetworkType: NetworkTypeMainnet}},
+	{Family: FamilyEVM, ChainID: "61166", ChainDetails: ChainDetails{ChainSelector: 5214452172935136222, ChainName: "treasure-mainnet", NetworkType: NetworkTypeMainnet, Deprecated: true}},
+	{Family: FamilyEVM, ChainID: "61900", ChainDetails: ChainDetails{ChainSelector: 3314641565992046393, ChainName: "mova-mainnet", NetworkType: NetworkTypeMainnet, Deprecated: true}},
+	{Family: FamilyEVM, ChainID: "61901", ChainDetails: ChainDetails{ChainSelector: 4215185756725900654, ChainName: "mova-mainnet-2", NetworkType: NetworkTypeMainnet}},
+	{Family: FamilyEVM, ChainID: "68414", ChainDetails: ChainDetails{ChainSelector: 12657445206920369324, ChainName: "nexon-mainnet-henesys", NetworkType: NetworkTypeMainnet}},
+	{Family: FamilyEVM, ChainID: "76578", ChainDetails: ChainDetails{ChainSelector: 781901677223027175, NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "80001", ChainDetails: ChainDetails{ChainSelector: 12532609583862916517, ChainName: "polygon-testnet-mumbai", NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "80002", ChainDetails: ChainDetails{ChainSelector: 16281711391670634445, ChainName: "polygon-testnet-amoy", NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "80069", ChainDetails: ChainDetails{ChainSelector: 7728255861635209484, ChainName: "berachain-testnet-bepolia", NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "80084", ChainDetails: ChainDetails{ChainSelector: 8999465244383784164, ChainName: "berachain-testnet-bartio", NetworkType: NetworkTypeTestnet, Deprecated: true}},
+	{Family: FamilyEVM, ChainID: "80085", ChainDetails: ChainDetails{ChainSelector: 12336603543561911511, ChainName: "berachain-testnet-artio", NetworkType: NetworkTypeTestnet, Deprecated: true}},
+	{Family: FamilyEVM, ChainID: "80087", ChainDetails: ChainDetails{ChainSelector: 2285225387454015855, ChainName: "zero-g-testnet-galileo", NetworkType: NetworkTypeTestnet, Deprecated: true}},
+	{Family: FamilyEVM, ChainID: "80094", ChainDetails: ChainDetails{ChainSelector: 1294465214383781161, ChainName: "berachain-mainnet", NetworkType: NetworkTypeMainnet}},
+	{Family: FamilyEVM, ChainID: "81224", ChainDetails: ChainDetails{ChainSelector: 9478124434908827753, ChainName: "codex-mainnet", NetworkType: NetworkTypeMainnet}},
+	{Family: FamilyEVM, ChainID: "81457", ChainDetails: ChainDetails{ChainSelector: 4411394078118774322, ChainName: "ethereum-mainnet-blast-1", NetworkType: NetworkTypeMainnet, Deprecated: true}},
+	{Family: FamilyEVM, ChainID: "84531", ChainDetails: ChainDetails{ChainSelector: 5790810961207155433, ChainName: "ethereum-testnet-goerli-base-1", NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "84532", ChainDetails: ChainDetails{ChainSelector: 10344971235874465080, ChainName: "ethereum-testnet-sepolia-base-1", NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "98864", ChainDetails: ChainDetails{ChainSelector: 3743020999916460931, ChainName: "plume-devnet", NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "98865", ChainDetails: ChainDetails{ChainSelector: 3208172210661564830, NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "98866", ChainDetails: ChainDetails{ChainSelector: 17912061998839310979, ChainName: "plume-mainnet", NetworkType: NetworkTypeMainnet}},
+	{Family: FamilyEVM, ChainID: "98867", ChainDetails: ChainDetails{ChainSelector: 13874588925447303949, ChainName: "plume-testnet-sepolia", NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "99999", ChainDetails: ChainDetails{ChainSelector: 9418205736192840573, ChainName: "adi-testnet", NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "102030", ChainDetails: ChainDetails{ChainSelector: 18240105181246962294, ChainName: "creditcoin-mainnet", NetworkType: NetworkTypeMainnet}},
+	{Family: FamilyEVM, ChainID: "102031", ChainDetails: ChainDetails{ChainSelector: 16960985330067274105, ChainName: "creditcoin-testnet", NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "128123", ChainDetails: ChainDetails{ChainSelector: 1910019406958449359, ChainName: "etherlink-testnet", NetworkType: NetworkTypeTestnet, Deprecated: true}},
+	{Family: FamilyEVM, ChainID: "129399", ChainDetails: ChainDetails{ChainSelector: 9090863410735740267, ChainName: "polygon-testnet-tatara", NetworkType: NetworkTypeTestnet, Deprecated: true}},
+	{Family: FamilyEVM, ChainID: "167000", ChainDetails: ChainDetails{ChainSelector: 16468599424800719238, ChainName: "ethereum-mainnet-taiko-1", NetworkType: NetworkTypeMainnet}},
+	{Family: FamilyEVM, ChainID: "167009", ChainDetails: ChainDetails{ChainSelector: 7248756420937879088, ChainName: "ethereum-testnet-holesky-taiko-1", NetworkType: NetworkTypeTestnet, Deprecated: true}},
+	{Family: FamilyEVM, ChainID: "167012", ChainDetails: ChainDetails{ChainSelector: 9873759436596923887, ChainName: "ethereum-testnet-hoodi-taiko", NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "167013", ChainDetails: ChainDetails{ChainSelector: 15858691699034549072, ChainName: "ethereum-testnet-hoodi-taiko-1", NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "192940", ChainDetails: ChainDetails{ChainSelector: 7189150270347329685, ChainName: "mind-testnet", NetworkType: NetworkTypeTestnet, Deprecated: true}},
+	{Family: FamilyEVM, ChainID: "200810", ChainDetails: ChainDetails{ChainSelector: 3789623672476206327, ChainName: "bitcoin-testnet-bitlayer-1", NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "200901", ChainDetails: ChainDetails{ChainSelector: 7937294810946806131, ChainName: "bitcoin-mainnet-bitlayer-1", NetworkType: NetworkTypeMainnet}},
+	{Family: FamilyEVM, ChainID: "202601", ChainDetails: ChainDetails{ChainSelector: 1091131740251125869, ChainName: "ethereum-testnet-sepolia-ronin-1", NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "364301", ChainDetails: ChainDetails{ChainSelector: 17611928792452358269, ChainName: "t-rex-testnet", NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "421613", ChainDetails: ChainDetails{ChainSelector: 6101244977088475029, ChainName: "ethereum-testnet-goerli-arbitrum-1", NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "421614", ChainDetails: ChainDetails{ChainSelector: 3478487238524512106, ChainName: "ethereum-testnet-sepolia-arbitrum-1", NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "424242", ChainDetails: ChainDetails{ChainSelector: 4489326297382772450, ChainName: "private-testnet-mica", NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "432201", ChainDetails: ChainDetails{ChainSelector: 1458281248224512906, ChainName: "avalanche-subnet-dexalot-testnet", NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "432204", ChainDetails: ChainDetails{ChainSelector: 5463201557265485081, ChainName: "avalanche-subnet-dexalot-mainnet", NetworkType: NetworkTypeMainnet}},
+	{Family: FamilyEVM, ChainID: "534351", ChainDetails: ChainDetails{ChainSelector: 2279865765895943307, ChainName: "ethereum-testnet-sepolia-scroll-1", NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "534352", ChainDetails: ChainDetails{ChainSelector: 13204309965629103672, ChainName: "ethereum-mainnet-scroll-1", NetworkType: NetworkTypeMainnet}},
+	{Family: FamilyEVM, ChainID: "560048", ChainDetails: ChainDetails{ChainSelector: 10380998176179737091, ChainName: "ethereum-testnet-hoodi", NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "595581", ChainDetails: ChainDetails{ChainSelector: 7837562506228496256, ChainName: "avalanche-testnet-nexon", NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "686868", ChainDetails: ChainDetails{ChainSelector: 5269261765892944301, ChainName: "bitcoin-testnet-merlin", NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "688688", ChainDetails: ChainDetails{ChainSelector: 4012524741200567430, ChainName: "pharos-testnet", NetworkType: NetworkTypeTestnet, Deprecated: true}},
+	{Family: FamilyEVM, ChainID: "688689", ChainDetails: ChainDetails{ChainSelector: 16098325658947243212, ChainName: "pharos-atlantic-testnet", NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "717160", ChainDetails: ChainDetails{ChainSelector: 4418231248214522936, ChainName: "ethereum-testnet-sepolia-polygon-validium-1", NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "743111", ChainDetails: ChainDetails{ChainSelector: 16126893759944359622, ChainName: "hemi-testnet-sepolia", NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "747474", ChainDetails: ChainDetails{ChainSelector: 2459028469735686113, ChainName: "polygon-mainnet-katana", NetworkType: NetworkTypeMainnet}},
+	{Family: FamilyEVM, ChainID: "763373", ChainDetails: ChainDetails{ChainSelector: 9763904284804119144, ChainName: "ink-testnet-sepolia", NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "807424", ChainDetails: ChainDetails{ChainSelector: 14632960069656270105, ChainName: "nexon-qa", NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "808813", ChainDetails: ChainDetails{ChainSelector: 5535534526963509396, ChainName: "bitcoin-testnet-sepolia-bob-1", NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "810180", ChainDetails: ChainDetails{ChainSelector: 4350319965322101699, ChainName: "zklink_nova-mainnet", NetworkType: NetworkTypeMainnet}},
+	{Family: FamilyEVM, ChainID: "810181", ChainDetails: ChainDetails{ChainSelector: 5837261596322416298, ChainName: "zklink_nova-testnet", NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "812242", ChainDetails: ChainDetails{ChainSelector: 7225665875429174318, ChainName: "codex-testnet", NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "847799", ChainDetails: ChainDetails{ChainSelector: 5556806327594153475, ChainName: "nexon-stage", NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "978657", ChainDetails: ChainDetails{ChainSelector: 10443705513486043421, ChainName: "ethereum-testnet-sepolia-arbitrum-1-treasure-1", NetworkType: NetworkTypeTestnet, Deprecated: true}},
+	{Family: FamilyEVM, ChainID: "978658", ChainDetails: ChainDetails{ChainSelector: 3676916124122457866, ChainName: "treasure-testnet-topaz", NetworkType: NetworkTypeTestnet, Deprecated: true}},
+	{Family: FamilyEVM, ChainID: "978670", ChainDetails: ChainDetails{ChainSelector: 1010349088906777999, ChainName: "ethereum-mainnet-arbitrum-1-treasure-1", NetworkType: NetworkTypeMainnet, Deprecated: true}},
+	{Family: FamilyEVM, ChainID: "2019775", ChainDetails: ChainDetails{ChainSelector: 945045181441419236, ChainName: "jovay-testnet", NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "5042002", ChainDetails: ChainDetails{ChainSelector: 3034092155422581607, ChainName: "arc-testnet", NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "5734951", ChainDetails: ChainDetails{ChainSelector: 1523760397290643893, ChainName: "jovay-mainnet", NetworkType: NetworkTypeMainnet}},
+	{Family: FamilyEVM, ChainID: "6281971", ChainDetails: ChainDetails{ChainSelector: 7254999290874773717, ChainName: "dogeos-testnet-chikyu", NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "7777777", ChainDetails: ChainDetails{ChainSelector: 3555797439612589184, ChainName: "zora-mainnet", NetworkType: NetworkTypeMainnet}},
+	{Family: FamilyEVM, ChainID: "11142220", ChainDetails: ChainDetails{ChainSelector: 3761762704474186180, ChainName: "celo-sepolia", NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "11155111", ChainDetails: ChainDetails{ChainSelector: 16015286601757825753, ChainName: "ethereum-testnet-sepolia", NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "11155420", ChainDetails: ChainDetails{ChainSelector: 5224473277236331295, ChainName: "ethereum-testnet-sepolia-optimism-1", NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "12227332", ChainDetails: ChainDetails{ChainSelector: 2217764097022649312, ChainName: "neox-testnet-t4", NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "21000000", ChainDetails: ChainDetails{ChainSelector: 9043146809313071210, ChainName: "corn-mainnet", NetworkType: NetworkTypeMainnet, Deprecated: true}},
+	{Family: FamilyEVM, ChainID: "21000001", ChainDetails: ChainDetails{ChainSelector: 1467427327723633929, ChainName: "ethereum-testnet-sepolia-corn-1", NetworkType: NetworkTypeTestnet, Deprecated: true}},
+	{Family: FamilyEVM, ChainID: "31415926", ChainDetails: ChainDetails{ChainSelector: 7060342227814389000, ChainName: "filecoin-testnet", NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "161221135", ChainDetails: ChainDetails{ChainSelector: 14684575664602284776, ChainName: "plume-testnet", NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "168587773", ChainDetails: ChainDetails{ChainSelector: 2027362563942762617, ChainName: "ethereum-testnet-sepolia-blast-1", NetworkType: NetworkTypeTestnet, Deprecated: true}},
+	{Family: FamilyEVM, ChainID: "728126428", ChainDetails: ChainDetails{ChainSelector: 1546563616611573946, ChainName: "tron-mainnet-evm", NetworkType: NetworkTypeMainnet}},
+	{Family: FamilyEVM, ChainID: "999999999", ChainDetails: ChainDetails{ChainSelector: 16244020411108056671, ChainName: "zora-testnet", NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "2026041002", ChainDetails: ChainDetails{ChainSelector: 4175996748267305081, ChainName: "private-testnet-quartzite", NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "2026041003", ChainDetails: ChainDetails{ChainSelector: 604447335222770945, ChainName: "private-testnet-rhyolite", NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "2026041004", ChainDetails: ChainDetails{ChainSelector: 1564738277398880633, ChainName: "private-testnet-pumice", NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "2026041005", ChainDetails: ChainDetails{ChainSelector: 13879014182901017172, ChainName: "dtcc-mainnet-appchain", NetworkType: NetworkTypeMainnet}},
+	{Family: FamilyEVM, ChainID: "2494104990", ChainDetails: ChainDetails{ChainSelector: 13231703482326770598, ChainName: "tron-testnet-shasta-evm", NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "3360022319", ChainDetails: ChainDetails{ChainSelector: 13231703482326770600, ChainName: "tron-devnet-evm", NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "3448148188", ChainDetails: ChainDetails{ChainSelector: 2052925811360307749, ChainName: "tron-testnet-nile-evm", NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "7052886157", ChainDetails: ChainDetails{ChainSelector: 410896468069059699, ChainName: "glamsterdam-devnet-6", NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "7095321190", ChainDetails: ChainDetails{ChainSelector: 10073034426865795585, ChainName: "glamsterdam-devnet-5", NetworkType: NetworkTypeTestnet, Deprecated: true}},
+}
+
+// evmTestSelectors holds the chains of test_selectors.yml.
+var evmTestSelectors = []chainEntry{
+	{Family: FamilyEVM, ChainID: "1000", ChainDetails: ChainDetails{ChainSelector: 11787463284727550157, NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "2337", ChainDetails: ChainDetails{ChainSelector: 12922642891491394802, ChainName: "geth-devnet-2", NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "3337", ChainDetails: ChainDetails{ChainSelector: 4793464827907405086, ChainName: "geth-devnet-3", NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "90000001", ChainDetails: ChainDetails{ChainSelector: 909606746561742123, NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "90000002", ChainDetails: ChainDetails{ChainSelector: 5548718428018410741, NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "90000003", ChainDetails: ChainDetails{ChainSelector: 789068866484373046, NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "90000004", ChainDetails: ChainDetails{ChainSelector: 5721565186521185178, NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "90000005", ChainDetails: ChainDetails{ChainSelector: 964127714438319834, NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "90000006", ChainDetails: ChainDetails{ChainSelector: 8966794841936584464, NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "90000007", ChainDetails: ChainDetails{ChainSelector: 8412806778050735057, NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "90000008", ChainDetails: ChainDetails{ChainSelector: 4066443121807923198, NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "90000009", ChainDetails: ChainDetails{ChainSelector: 6747736380229414777, NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "90000010", ChainDetails: ChainDetails{ChainSelector: 8694984074292254623, NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "90000011", ChainDetails: ChainDetails{ChainSelector: 328334718812072308, NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "90000012", ChainDetails: ChainDetails{ChainSelector: 7715160997071429212, NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "90000013", ChainDetails: ChainDetails{ChainSelector: 3574539439524578558, NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "90000014", ChainDetails: ChainDetails{ChainSelector: 4543928599863227519, NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "90000015", ChainDetails: ChainDetails{ChainSelector: 6443235356619661032, NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "90000016", ChainDetails: ChainDetails{ChainSelector: 13087962012083037329, NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "90000017", ChainDetails: ChainDetails{ChainSelector: 11985232338641871056, NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "90000018", ChainDetails: ChainDetails{ChainSelector: 7777066535355430289, NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "90000019", ChainDetails: ChainDetails{ChainSelector: 1273605685587320666, NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "90000020", ChainDetails: ChainDetails{ChainSelector: 17810359353458878177, NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "90000021", ChainDetails: ChainDetails{ChainSelector: 13648736134397881410, NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "90000022", ChainDetails: ChainDetails{ChainSelector: 6742472197519042017, NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "90000023", ChainDetails: ChainDetails{ChainSelector: 16702426279731183946, NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "90000024", ChainDetails: ChainDetails{ChainSelector: 16449698933146693970, NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "90000025", ChainDetails: ChainDetails{ChainSelector: 5614341928911841614, NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "90000026", ChainDetails: ChainDetails{ChainSelector: 9932483170498916221, NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "90000027", ChainDetails: ChainDetails{ChainSelector: 9248511054298050610, NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "90000028", ChainDetails: ChainDetails{ChainSelector: 15733873364998401606, NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "90000029", ChainDetails: ChainDetails{ChainSelector: 10199579733509604193, NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "90000030", ChainDetails: ChainDetails{ChainSelector: 11754399446572002459, NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "90000031", ChainDetails: ChainDetails{ChainSelector: 15804983202763665802, NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "90000032", ChainDetails: ChainDetails{ChainSelector: 8794884152664322911, NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "90000033", ChainDetails: ChainDetails{ChainSelector: 7005880874640146484, NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "90000034", ChainDetails: ChainDetails{ChainSelector: 15998314635132476942, NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "90000035", ChainDetails: ChainDetails{ChainSelector: 6676710761873615962, NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "90000036", ChainDetails: ChainDetails{ChainSelector: 13973515790491921010, NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "90000037", ChainDetails: ChainDetails{ChainSelector: 12226902941055802385, NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "90000038", ChainDetails: ChainDetails{ChainSelector: 10547673735879567911, NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "90000039", ChainDetails: ChainDetails{ChainSelector: 2953028829530698683, NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "90000040", ChainDetails: ChainDetails{ChainSelector: 3740583887329090549, NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "90000041", ChainDetails: ChainDetails{ChainSelector: 4716670523656754658, NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "90000042", ChainDetails: ChainDetails{ChainSelector: 12965905455277595820, NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "90000043", ChainDetails: ChainDetails{ChainSelector: 6448403805635971860, NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "90000044", ChainDetails: ChainDetails{ChainSelector: 176199025415897437, NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "90000045", ChainDetails: ChainDetails{ChainSelector: 17251043223284625647, NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "90000046", ChainDetails: ChainDetails{ChainSelector: 14943531413383612703, NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "90000047", ChainDetails: ChainDetails{ChainSelector: 8015762103567576333, NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "90000048", ChainDetails: ChainDetails{ChainSelector: 2783890746839497525, NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "90000049", ChainDetails: ChainDetails{ChainSelector: 16591966440843528322, NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "90000050", ChainDetails: ChainDetails{ChainSelector: 9156614022853705708, NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "90000051", ChainDetails: ChainDetails{ChainSelector: 10089241509396411113, NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "90000052", ChainDetails: ChainDetails{ChainSelector: 7585715102059681757, NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "90000053", ChainDetails: ChainDetails{ChainSelector: 9574369650680012313, NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "90000054", ChainDetails: ChainDetails{ChainSelector: 15767478222558315144, NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "90000055", ChainDetails: ChainDetails{ChainSelector: 928756709184343973, NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "90000056", ChainDetails: ChainDetails{ChainSelector: 13936493323944617843, NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "90000057", ChainDetails: ChainDetails{ChainSelector: 9264503539336248559, NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "90000058", ChainDetails: ChainDetails{ChainSelector: 7032045258883126022, NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "90000059", ChainDetails: ChainDetails{ChainSelector: 13781595843667691007, NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "90000060", ChainDetails: ChainDetails{ChainSelector: 6751512843227450641, NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "90000061", ChainDetails: ChainDetails{ChainSelector: 12027427861168955422, NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "90000062", ChainDetails: ChainDetails{ChainSelector: 6690738652320128159, NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "90000063", ChainDetails: ChainDetails{ChainSelector: 12513826466599144030, NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "90000064", ChainDetails: ChainDetails{ChainSelector: 7823363553221722351, NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "90000065", ChainDetails: ChainDetails{ChainSelector: 17759418850483131633, NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "90000066", ChainDetails: ChainDetails{ChainSelector: 1488785539820432596, NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "90000067", ChainDetails: ChainDetails{ChainSelector: 12470167056735102403, NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "90000068", ChainDetails: ChainDetails{ChainSelector: 6059917085984771915, NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "90000069", ChainDetails: ChainDetails{ChainSelector: 8698844633699288298, NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "90000070", ChainDetails: ChainDetails{ChainSelector: 11335955773964346155, NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "90000071", ChainDetails: ChainDetails{ChainSelector: 15210860601736105873, NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "90000072", ChainDetails: ChainDetails{ChainSelector: 15447447865219782832, NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "90000073", ChainDetails: ChainDetails{ChainSelector: 7404045285477377670, NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "90000074", ChainDetails: ChainDetails{ChainSelector: 14506622911400094011, NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "90000075", ChainDetails: ChainDetails{ChainSelector: 18316006852148771137, NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "90000076", ChainDetails: ChainDetails{ChainSelector: 7961714422080771198, NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "90000077", ChainDetails: ChainDetails{ChainSelector: 15168140751097121912, NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "90000078", ChainDetails: ChainDetails{ChainSelector: 8354317460459584308, NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "90000079", ChainDetails: ChainDetails{ChainSelector: 1974710175227680991, NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "90000080", ChainDetails: ChainDetails{ChainSelector: 15896959195233368219, NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "90000081", ChainDetails: ChainDetails{ChainSelector: 13819071330241498802, NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "90000082", ChainDetails: ChainDetails{ChainSelector: 3632230855428784129, NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "90000083", ChainDetails: ChainDetails{ChainSelector: 3330151784927722907, NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "90000084", ChainDetails: ChainDetails{ChainSelector: 973671184102733124, NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "90000085", ChainDetails: ChainDetails{ChainSelector: 7353384334508842175, NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "90000086", ChainDetails: ChainDetails{ChainSelector: 4174149892778961910, NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "90000087", ChainDetails: ChainDetails{ChainSelector: 10497629267361915835, NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "90000088", ChainDetails: ChainDetails{ChainSelector: 10537986502862404866, NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "90000089", ChainDetails: ChainDetails{ChainSelector: 10106333385848939617, NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "90000090", ChainDetails: ChainDetails{ChainSelector: 2509173735760116798, NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "90000091", ChainDetails: ChainDetails{ChainSelector: 12499149790922928210, NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "90000092", ChainDetails: ChainDetails{ChainSelector: 665284410079532457, NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "90000093", ChainDetails: ChainDetails{ChainSelector: 17514102371649734225, NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "90000094", ChainDetails: ChainDetails{ChainSelector: 8211981504472319767, NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "90000095", ChainDetails: ChainDetails{ChainSelector: 15945074456050759193, NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "90000096", ChainDetails: ChainDetails{ChainSelector: 17580537314894454709, NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "90000097", ChainDetails: ChainDetails{ChainSelector: 13443138560923813712, NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "90000098", ChainDetails: ChainDetails{ChainSelector: 9675086780529785020, NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "90000099", ChainDetails: ChainDetails{ChainSelector: 7431973150957944526, NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyEVM, ChainID: "90000100", ChainDetails: ChainDetails{ChainSelector: 6875898693582952601, NetworkType: NetworkTypeTestnet}},
+}
+
+// solanaSelectors holds the chains of selectors_solana.yml.
+var solanaSelectors = []chainEntry{
+	{Family: FamilySolana, ChainID: "4uhcVJyU9pJkvQyS88uRDiswHXSCkY3zQawwpjk2NsNY", ChainDetails: ChainDetails{ChainSelector: 6302590918974934319, ChainName: "solana-testnet", NetworkType: NetworkTypeTestnet}},
+	{Family: FamilySolana, ChainID: "5eykt4UsFv8P8NJdTREpY1vzqKqZKvdpKuc147dw2N9d", ChainDetails: ChainDetails{ChainSelector: 124615329519749607, ChainName: "solana-mainnet", NetworkType: NetworkTypeMainnet}},
+	{Family: FamilySolana, ChainID: "EtWTRABZaYq6iMfeYKouRu166VU2xqa1wcaWoxPkrZBG", ChainDetails: ChainDetails{ChainSelector: 16423721717087811551, ChainName: "solana-devnet", NetworkType: NetworkTypeTestnet}},
+}
+
+// solanaTestSelectors holds the chains of test_selectors_solana.yml.
+var solanaTestSelectors = []chainEntry{
+	{Family: FamilySolana, ChainID: "22222222222222222222222222222222222222222222", ChainDetails: ChainDetails{ChainSelector: 12463857294658392847, NetworkType: NetworkTypeTestnet}},
+	{Family: FamilySolana, ChainID: "33333333333333333333333333333333333333333333", ChainDetails: ChainDetails{ChainSelector: 9837465928374658293, NetworkType: NetworkTypeTestnet}},
+	{Family: FamilySolana, ChainID: "44444444444444444444444444444444444444444444", ChainDetails: ChainDetails{ChainSelector: 16574839267584930184, NetworkType: NetworkTypeTestnet}},
+}
+
+// aptosSelectors holds the chains of selectors_aptos.yml.
+var aptosSelectors = []chainEntry{
+	{Family: FamilyAptos, ChainID: "1", ChainDetails: ChainDetails{ChainSelector: 4741433654826277614, ChainName: "aptos-mainnet", NetworkType: NetworkTypeMainnet}},
+	{Family: FamilyAptos, ChainID: "2", ChainDetails: ChainDetails{ChainSelector: 743186221051783445, ChainName: "aptos-testnet", NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyAptos, ChainID: "4", ChainDetails: ChainDetails{ChainSelector: 4457093679053095497, ChainName: "aptos-localnet", NetworkType: NetworkTypeTestnet}},
+}
+
+// suiSelectors holds the chains of selectors_sui.yml.
+var suiSelectors = []chainEntry{
+	{Family: FamilySui, ChainID: "1", ChainDetails: ChainDetails{ChainSelector: 17529533435026248318, ChainName: "sui-mainnet", NetworkType: NetworkTypeMainnet}},
+	{Family: FamilySui, ChainID: "2", ChainDetails: ChainDetails{ChainSelector: 9762610643973837292, ChainName: "sui-testnet", NetworkType: NetworkTypeTestnet}},
+	{Family: FamilySui, ChainID: "4", ChainDetails: ChainDetails{ChainSelector: 18395503381733958356, ChainName: "sui-localnet", NetworkType: NetworkTypeTestnet}},
+}
+
+// tonSelectors holds the chains of selectors_ton.yml.
+var tonSelectors = []chainEntry{
+	{Family: FamilyTon, ChainID: "-239", ChainDetails: ChainDetails{ChainSelector: 16448340667252469081, ChainName: "ton-mainnet", NetworkType: NetworkTypeMainnet}},
+	{Family: FamilyTon, ChainID: "-217", ChainDetails: ChainDetails{ChainSelector: 13879075125137744094, ChainName: "ton-localnet", NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyTon, ChainID: "-3", ChainDetails: ChainDetails{ChainSelector: 1399300952838017768, ChainName: "ton-testnet", NetworkType: NetworkTypeTestnet}},
+}
+
+// tronSelectors holds the chains of selectors_tron.yml.
+var tronSelectors = []chainEntry{
+	{Family: FamilyTron, ChainID: "728126428", ChainDetails: ChainDetails{ChainSelector: 1546563616611573945, ChainName: "tron-mainnet", NetworkType: NetworkTypeMainnet}},
+	{Family: FamilyTron, ChainID: "2494104990", ChainDetails: ChainDetails{ChainSelector: 13231703482326770597, ChainName: "tron-testnet-shasta", NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyTron, ChainID: "3360022319", ChainDetails: ChainDetails{ChainSelector: 13231703482326770599, ChainName: "tron-devnet", NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyTron, ChainID: "3448148188", ChainDetails: ChainDetails{ChainSelector: 2052925811360307740, ChainName: "tron-testnet-nile", NetworkType: NetworkTypeTestnet}},
+}
+
+// starknetSelectors holds the chains of selectors_starknet.yml.
+var starknetSelectors = []chainEntry{
+	{Family: FamilyStarknet, ChainID: "SN_MAIN", ChainDetails: ChainDetails{ChainSelector: 511843109281680063, ChainName: "ethereum-mainnet-starknet-1", NetworkType: NetworkTypeMainnet}},
+	{Family: FamilyStarknet, ChainID: "SN_SEPOLIA", ChainDetails: ChainDetails{ChainSelector: 4115550741429562104, ChainName: "ethereum-testnet-sepolia-starknet-1", NetworkType: NetworkTypeTestnet}},
+}
+
+// cantonSelectors holds the chains of selectors_canton.yml.
+var cantonSelectors = []chainEntry{
+	{Family: FamilyCanton, ChainID: "DevNet", ChainDetails: ChainDetails{ChainSelector: 10109143320554840099, ChainName: "canton-devnet", NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyCanton, ChainID: "LocalNet", ChainDetails: ChainDetails{ChainSelector: 8706591216959472610, ChainName: "canton-localnet", NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyCanton, ChainID: "MainNet", ChainDetails: ChainDetails{ChainSelector: 2308837218439511688, ChainName: "canton-mainnet", NetworkType: NetworkTypeMainnet}},
+	{Family: FamilyCanton, ChainID: "TestNet", ChainDetails: ChainDetails{ChainSelector: 9268731218649498074, ChainName: "canton-testnet", NetworkType: NetworkTypeTestnet}},
+}
+
+// stellarSelectors holds the chains of selectors_stellar.yml.
+var stellarSelectors = []chainEntry{
+	{Family: FamilyStellar, ChainID: "7ac33997544e3175d266bd022439b22cdb16508c01163f26e5cb2a3e1045a979", ChainDetails: ChainDetails{ChainSelector: 17783245649066640917, ChainName: "stellar-mainnet", NetworkType: NetworkTypeMainnet}},
+	{Family: FamilyStellar, ChainID: "baefd734b8d3e48472cff83912375fedbc7573701912fe308af730180f97d74a", ChainDetails: ChainDetails{ChainSelector: 17301180955411967724, ChainName: "stellar-localnet", NetworkType: NetworkTypeTestnet}},
+	{Family: FamilyStellar, ChainID: "cee0302d59844d32bdca915c8203dd44b33fbb7edc19051ea37abedf28ecd472", ChainDetails: ChainDetails{ChainSelector: 4894814558906953166, ChainName: "stellar-testnet", NetworkType: NetworkTypeTestnet}},
+}
+
+// cosmosSelectors holds the chains of selectors_cosmos.yml.
+var cosmosSelectors = []chainEntry{}
+
+// stellarPassphraseFromChainId returns the network passphrase of selectors_stellar.yml for a Stellar chain ID.
+func stellarPassphraseFromChainId(chainID string) (string, bool) {
+	switch chainID {
+	case "7ac33997544e3175d266bd022439b22cdb16508c01163f26e5cb2a3e1045a979":
+		return "Public Global Stellar Network ; September 2015", true
+	case "baefd734b8d3e48472cff83912375fedbc7573701912fe308af730180f97d74a":
+		return "Standalone Network ; February 2017", true
+	case "cee0302d59844d32bdca915c8203dd44b33fbb7edc19051ea37abedf28ecd472":
+		return "Test SDF Network ; September 2015", true
+	}
+	return "", false
+}
@@ -0,0 +1,215 @@
+//go:build ignore
+
+package main
+
+import (
+	"bytes"
+	"cmp"
+	"fmt"
+	"go/format"
+	"os"
+	"sort"
+	"text/template"
+
+	chain_selectors "github.com/smartcontractkit/chain-selectors"
+	"gopkg.in/yaml.v3"
+)
+
+const filename = "generated_selectors.go"
+
+// table is the generated slice holding the chains of one selectors file.
+type table struct {
+	VarName string
+	Source  string
+	Family  string
+	Chains  []chain
+}
+
+type chain struct {
+	ChainID string
+	Details chain_selectors.ChainDetails
+}
+
+type passphrase struct {
+	ChainID    string
+	Passphrase string
+}
+
+var selectorsTemplate = template.Must(template.New("").Funcs(template.FuncMap{
+	"networkType": func(networkType chain_selectors.NetworkType) string {
+		switch networkType {
+		case chain_selectors.NetworkTypeMainnet:
+			return "NetworkTypeMainnet"
+		case chain_selectors.NetworkTypeTestnet:
+			return "NetworkTypeTestnet"
+		default:
+			return fmt.Sprintf("%q", networkType)
+		}
+	},
+}).Parse(`// Code generated by go generate please DO NOT EDIT
+package chain_selectors
+
+// embeddedChains holds the chains of every selectors file embedded in this package.
+var embeddedChains = [][]chainEntry{
+{{- range .Tables }}
+	{{ .VarName }},
+{{- end }}
+}
+{{ range $t := .Tables }}
+// {{ $t.VarName }} holds the chains of {{ $t.Source }}.
+var {{ $t.VarName }} = []chainEntry{
+{{- range $t.Chains }}
+	{Family: {{ $t.Family }}, ChainID: {{ printf "%q" .ChainID }}, ChainDetails: ChainDetails{ChainSelector: {{ .Details.ChainSelector }}
+		{{- if .Details.ChainName }}, ChainName: {{ printf "%q" .Details.ChainName }}{{ end }}
+		{{- if .Details.NetworkType }}, NetworkType: {{ networkType .Details.NetworkType }}{{ end }}
+		{{- if .Details.Deprecated }}, Deprecated: true{{ end }}}},
+{{- end }}
+}
+{{ end }}
+// stellarPassphraseFromChainId returns the network passphrase of selectors_stellar.yml for a Stellar chain ID.
+func stellarPassphraseFromChainId(chainID string) (string, bool) {
+	switch chainID {
+{{- range .Passphrases }}
+	case {{ printf "%q" .ChainID }}:
+		return {{ printf "%q" .Passphrase }}, true
+{{- end }}
+	}
+	return "", false
+}
+`))
+
+func main() {
+	src, err := genSelectorsSourceCode()
+	if err != nil {
+		panic(err)
+	}
+
+	formatted, err := format.Source([]byte(src))
+	if err != nil {
+		panic(err)
+	}
+
+	existingContent, err := os.ReadFile(filename)
+	if err != nil && !os.IsNotExist(err) {
+		panic(err)
+	}
+
+	if string(existingContent) == string(formatted) {
+		fmt.Println("selectors: no changes detected")
+		return
+	}
+	fmt.Println("selectors: updating generations")
+
+	err = os.WriteFile(filename, formatted, 0644)
+	if err != nil {
+		panic(err)
+	}
+}
+
+func genSelectorsSourceCode() (string, error) {
+	tables := []struct {
+		table
+		read func(filename string) ([]chain, error)
+	}{
+		{table{VarName: "evmSelectors", Source: "selectors.yml", Family: "FamilyEVM"}, readSelectors[uint64]},
+		{table{VarName: "evmTestSelectors", Source: "test_selectors.yml", Family: "FamilyEVM"}, readTestnetSelectors[uint64]},
+		{table{VarName: "solanaSelectors", Source: "selectors_solana.yml", Family: "FamilySolana"}, readSelectors[string]},
+		{table{VarName: "solanaTestSelectors", Source: "test_selectors_solana.yml", Family: "FamilySolana"}, readSelectors[string]},
+		{table{VarName: "aptosSelectors", Source: "selectors_aptos.yml", Family: "FamilyAptos"}, readSelectors[uint64]},
+		{table{VarName: "suiSelectors", Source: "selectors_sui.yml", Family: "FamilySui"}, readSelectors[uint64]},
+		{table{VarName: "tonSelectors", Source: "selectors_ton.yml", Family: "FamilyTon"}, readSelectors[int32]},
+		{table{VarName: "tronSelectors", Source: "selectors_tron.yml", Family: "FamilyTron"}, readSelectors[uint64]},
+		{table{VarName: "starknetSelectors", Source: "selectors_starknet.yml", Family: "FamilyStarknet"}, readSelectors[string]},
+		{table{VarName: "cantonSelectors", Source: "selectors_canton.yml", Family: "FamilyCanton"}, readSelectors[string]},
+		{table{VarName: "stellarSelectors", Source: "selectors_stellar.yml", Family: "FamilyStellar"}, readSelectors[string]},
+		{table{VarName: "cosmosSelectors", Source: "selectors_cosmos.yml", Family: "FamilyCosmos"}, readSelectors[string]},
+	}
+
+	generated := make([]table, 0, len(tables))
+	for _, t := range tables {
+		chains, err := t.read(t.Source)
+		if err != nil {
+			return "", fmt.Errorf("failed to read %s: %w", t.Source, err)
+		}
+		t.table.Chains = chains
+		generated = append(generated, t.table)
+	}
+
+	passphrases, err := readStellarPassphrases("selectors_stellar.yml")
+	if err != nil {
+		return "", err
+	}
+
+	var wr = new(bytes.Buffer)
+	err = selectorsTemplate.Execute(wr, struct {
+		Tables      []table
+		Passphrases []passphrase
+	}{generated, passphrases})
+	if err != nil {
+		return "", err
+	}
+	return wr.String(), nil
+}
+
+// readSelectors returns the chains of a selectors file sorted by chain ID. K is the type of the chain IDs
+// of the family, so that they are formatted the same way as GetChainIDFromSelector returns them.
+func readSelectors[K cmp.Ordered](filename string) ([]chain, error) {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	var parsed struct {
+		Selectors map[K]chain_selectors.ChainDetails `yaml:"selectors"`
+	}
+	if err := yaml.Unmarshal(data, &parsed); err != nil {
+		return nil, err
+	}
+
+	chainIDs := make([]K, 0, len(parsed.Selectors))
+	for chainID := range parsed.Selectors {
+		chainIDs = append(chainIDs, chainID)
+	}
+	sort.Slice(chainIDs, func(i, j int) bool { return chainIDs[i] < chainIDs[j] })
+
+	chains := make([]chain, 0, len(chainIDs))
+	for _, chainID := range chainIDs {
+		chains = append(chains, chain{ChainID: fmt.Sprint(chainID), Details: parsed.Selectors[chainID]})
+	}
+	return chains, nil
+}
+
+// readTestnetSelectors reads a selectors file whose chains are all testnets, e.g. test_selectors.yml.
+func readTestnetSelectors[K cmp.Ordered](filename string) ([]chain, error) {
+	chains, err := readSelectors[K](filename)
+	for i := range chains {
+		chains[i].Details.NetworkType = chain_selectors.NetworkTypeTestnet
+	}
+	return chains, err
+}
+
+// readStellarPassphrases returns the network passphrases of the Stellar chains, which are not part of ChainDetails.
+func readStellarPassphrases(filename string) ([]passphrase, error) {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	var parsed struct {
+		Selectors map[string]struct {
+			Passphrase string `yaml:"passphrase"`
+		} `yaml:"selectors"`
+	}
+	if err := yaml.Unmarshal(data, &parsed); err != nil {
+		return nil, err
+	}
+
+	passphrases := make([]passphrase, 0, len(parsed.Selectors))
+	for chainID, v := range parsed.Selectors {
+		if v.Passphrase != "" {
+			passphrases = append(passphrases, passphrase{ChainID: chainID, Passphrase: v.Passphrase})
+		}
+	}
+	sort.Slice(passphrases, func(i, j int) bool { return passphrases[i].ChainID < passphrases[j].ChainID })
+	return passphrases, nil
+}
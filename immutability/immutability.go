@@ -0,0 +1,265 @@
+// Package immutability compares two snapshots of the chain selectors and reports changes
+// that break the guarantee that a published selector always identifies the same chain.
+package immutability
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	chain_selectors "github.com/smartcontractkit/chain-selectors"
+	"gopkg.in/yaml.v3"
+)
+
+// Snapshot holds the chains of every family keyed by family and chain ID.
+type Snapshot map[string]map[string]chain_selectors.ChainDetails
+
+// familyFiles lists the selector files of a repository snapshot for every family
+var familyFiles = map[string][]string{
+	chain_selectors.FamilyEVM:      {"selectors.yml", "test_selectors.yml"},
+	chain_selectors.FamilySolana:   {"selectors_solana.yml", "test_selectors_solana.yml"},
+	chain_selectors.FamilyAptos:    {"selectors_aptos.yml"},
+	chain_selectors.FamilySui:      {"selectors_sui.yml"},
+	chain_selectors.FamilyTon:      {"selectors_ton.yml"},
+	chain_selectors.FamilyTron:     {"selectors_tron.yml"},
+	chain_selectors.FamilyStarknet: {"selectors_starknet.yml"},
+	chain_selectors.FamilyCanton:   {"selectors_canton.yml"},
+	chain_selectors.FamilyStellar:  {"selectors_stellar.yml"},
+	chain_selectors.FamilyCosmos:   {"selectors_cosmos.yml"},
+}
+
+// LoadSnapshot loads a snapshot from path, which is either a directory holding the selector files
+// of every family, e.g. a checkout of this repository, or an all_selectors.yml file.
+func LoadSnapshot(path string) (Snapshot, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	if info.IsDir() {
+		return loadDir(path)
+	}
+	return loadAllSelectors(path)
+}
+
+// loadDir loads the selector files of every family in dir. Missing files are treated as empty,
+// so snapshots taken before a family was added can be compared.
+func loadDir(dir string) (Snapshot, error) {
+	snapshot := make(Snapshot)
+	for family, files := range familyFiles {
+		for _, file := range files {
+			path := filepath.Join(dir, file)
+			content, err := os.ReadFile(path)
+			if errors.Is(err, os.ErrNotExist) {
+				continue
+			}
+			if err != nil {
+				return nil, err
+			}
+
+			var data struct {
+				Selectors map[string]chain_selectors.ChainDetails `yaml:"selectors"`
+			}
+			if err := yaml.Unmarshal(content, &data); err != nil {
+				return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+			}
+			for chainID, details := range data.Selectors {
+				snapshot.add(family, chainID, details)
+			}
+		}
+	}
+	return snapshot, nil
+}
+
+// loadAllSelectors loads an all_selectors.yml file
+func loadAllSelectors(path string) (Snapshot, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var data map[string]map[string]chain_selectors.ChainDetails
+	if err := yaml.Unmarshal(content, &data); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+
+	snapshot := make(Snapshot)
+	for family, chains := range data {
+		for chainID, details := range chains {
+			snapshot.add(family, chainID, details)
+		}
+	}
+	return snapshot, nil
+}
+
+func (s Snapshot) add(family, chainID string, details chain_selectors.ChainDetails) {
+	if _, exists := s[family]; !exists {
+		s[family] = make(map[string]chain_selectors.ChainDetails)
+	}
+	s[family][chainID] = details
+}
+
+// chainRef identifies a chain within a snapshot
+type chainRef struct {
+	family  string
+	chainID string
+}
+
+func (s Snapshot) bySelector() map[uint64]chainRef {
+	index := make(map[uint64]chainRef)
+	for family, chains := range s {
+		for chainID, details := range chains {
+			index[details.ChainSelector] = chainRef{family: family, chainID: chainID}
+		}
+	}
+	return index
+}
+
+// Kind is the kind of a reported change.
+type Kind string
+
+const (
+	// KindSelectorModified is a chain whose selector changed
+	KindSelectorModified Kind = "selector_modified"
+	// KindChainRemoved is a chain that no longer exists
+	KindChainRemoved Kind = "chain_removed"
+	// KindSelectorMoved is a selector that now identifies a chain of another family or with another chain ID
+	KindSelectorMoved Kind = "selector_moved"
+	// KindSelectorReused is a new chain using the selector of a chain that still exists
+	KindSelectorReused Kind = "selector_reused"
+	// KindNameChanged is a chain whose name changed. Names may change on rebrands, so this is not a violation.
+	KindNameChanged Kind = "name_changed"
+)
+
+// Violation reports whether changes of this kind violate selector immutability.
+func (k Kind) Violation() bool {
+	return k != KindNameChanged
+}
+
+// Change is a difference between two snapshots that affects an existing chain.
+type Change struct {
+	Kind    Kind   `json:"kind"`
+	Family  string `json:"family"`
+	ChainID string `json:"chain_id"`
+	// Selector and Name identify the chain in the previous snapshot
+	Selector uint64 `json:"selector"`
+	Name     string `json:"name"`
+	// NewSelector and NewName are the selector and name in the current snapshot, unset for removed chains
+	NewSelector uint64 `json:"new_selector,omitempty"`
+	NewName     string `json:"new_name,omitempty"`
+	// NewFamily and NewChainID identify the chain now using the selector, for moved and reused selectors
+	NewFamily  string `json:"new_family,omitempty"`
+	NewChainID string `json:"new_chain_id,omitempty"`
+	Message    string `json:"message"`
+}
+
+// Report holds the changes between two snapshots sorted by family and chain ID.
+type Report struct {
+	Changes []Change `json:"changes"`
+}
+
+// Violations returns the changes that violate selector immutability.
+func (r Report) Violations() []Change {
+	var violations []Change
+	for _, change := range r.Changes {
+		if change.Kind.Violation() {
+			violations = append(violations, change)
+		}
+	}
+	return violations
+}
+
+// HasViolations reports whether any change violates selector immutability.
+func (r Report) HasViolations() bool {
+	return len(r.Violations()) > 0
+}
+
+// Compare returns the changes to the chains of previous found in current.
+// Chains added in current are only reported when they reuse the selector of an existing chain.
+func Compare(previous, current Snapshot) Report {
+	currentBySelector := current.bySelector()
+	previousBySelector := previous.bySelector()
+
+	var changes []Change
+	for family, chains := range previous {
+		for chainID, old := range chains {
+			change := Change{Family: family, ChainID: chainID, Selector: old.ChainSelector, Name: old.ChainName}
+
+			updated, exists := current[family][chainID]
+			if !exists {
+				if moved, exists := currentBySelector[old.ChainSelector]; exists {
+					change.Kind = KindSelectorMoved
+					change.NewFamily = moved.family
+					change.NewChainID = moved.chainID
+					change.NewSelector = old.ChainSelector
+					change.NewName = current[moved.family][moved.chainID].ChainName
+					change.Message = fmt.Sprintf("selector %d moved from %s chain %s to %s chain %s",
+						old.ChainSelector, family, chainID, moved.family, moved.chainID)
+				} else {
+					change.Kind = KindChainRemoved
+					change.Message = fmt.Sprintf("%s chain %s with selector %d was removed", family, chainID, old.ChainSelector)
+				}
+				changes = append(changes, change)
+				continue
+			}
+
+			change.NewSelector = updated.ChainSelector
+			change.NewName = updated.ChainName
+			if updated.ChainSelector != old.ChainSelector {
+				change.Kind = KindSelectorModified
+				change.Message = fmt.Sprintf("%s chain %s changed selector from %d to %d",
+					family, chainID, old.ChainSelector, updated.ChainSelector)
+				changes = append(changes, change)
+				continue
+			}
+			if updated.ChainName != old.ChainName {
+				change.Kind = KindNameChanged
+				change.Message = fmt.Sprintf("%s chain %s changed name from %q to %q",
+					family, chainID, old.ChainName, updated.ChainName)
+				changes = append(changes, change)
+			}
+		}
+	}
+
+	// New chains must not take over the selector of a chain that still exists
+	for family, chains := range current {
+		for chainID, details := range chains {
+			if _, existed := previous[family][chainID]; existed {
+				continue
+			}
+			owner, exists := previousBySelector[details.ChainSelector]
+			if !exists {
+				continue
+			}
+			if _, stillExists := current[owner.family][owner.chainID]; !stillExists {
+				// Reported as a moved selector
+				continue
+			}
+			changes = append(changes, Change{
+				Kind:        KindSelectorReused,
+				Family:      owner.family,
+				ChainID:     owner.chainID,
+				Selector:    details.ChainSelector,
+				Name:        previous[owner.family][owner.chainID].ChainName,
+				NewSelector: details.ChainSelector,
+				NewName:     details.ChainName,
+				NewFamily:   family,
+				NewChainID:  chainID,
+				Message: fmt.Sprintf("selector %d of %s chain %s is reused by new %s chain %s",
+					details.ChainSelector, owner.family, owner.chainID, family, chainID),
+			})
+		}
+	}
+
+	sort.Slice(changes, func(i, j int) bool {
+		if changes[i].Family != changes[j].Family {
+			return changes[i].Family < changes[j].Family
+		}
+		if changes[i].ChainID != changes[j].ChainID {
+			return changes[i].ChainID < changes[j].ChainID
+		}
+		return changes[i].Kind < changes[j].Kind
+	})
+	return Report{Changes: changes}
+}
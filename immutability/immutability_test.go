@@ -0,0 +1,158 @@
+package immutability
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	chain_selectors "github.com/smartcontractkit/chain-selectors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeFile(t *testing.T, dir, name, content string) {
+	t.Helper()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0o600))
+}
+
+func TestLoadSnapshot(t *testing.T) {
+	t.Run("repository directory", func(t *testing.T) {
+		snapshot, err := LoadSnapshot("..")
+		require.NoError(t, err)
+
+		assert.Equal(t, chain_selectors.ETHEREUM_MAINNET.Selector, snapshot[chain_selectors.FamilyEVM]["1"].ChainSelector)
+		assert.Equal(t, chain_selectors.TON_MAINNET.Selector, snapshot[chain_selectors.FamilyTon]["-239"].ChainSelector)
+		for family := range familyFiles {
+			// No Cosmos selector has been allocated yet
+			if family != chain_selectors.FamilyCosmos {
+				assert.NotEmpty(t, snapshot[family], "family %s", family)
+			}
+		}
+	})
+
+	t.Run("all_selectors.yml", func(t *testing.T) {
+		snapshot, err := LoadSnapshot("../all_selectors.yml")
+		require.NoError(t, err)
+
+		assert.Equal(t, chain_selectors.ETHEREUM_MAINNET.Selector, snapshot[chain_selectors.FamilyEVM]["1"].ChainSelector)
+		assert.Equal(t, chain_selectors.STELLAR_MAINNET.Selector, snapshot[chain_selectors.FamilyStellar][chain_selectors.STELLAR_MAINNET.ChainID].ChainSelector)
+	})
+
+	t.Run("missing family files are empty", func(t *testing.T) {
+		dir := t.TempDir()
+		writeFile(t, dir, "selectors.yml", "selectors:\n  1:\n    selector: 5009297550715157269\n    name: ethereum-mainnet\n")
+
+		snapshot, err := LoadSnapshot(dir)
+		require.NoError(t, err)
+		assert.Len(t, snapshot, 1)
+		assert.Len(t, snapshot[chain_selectors.FamilyEVM], 1)
+	})
+
+	t.Run("invalid file", func(t *testing.T) {
+		dir := t.TempDir()
+		writeFile(t, dir, "selectors_aptos.yml", "selectors: [")
+
+		_, err := LoadSnapshot(dir)
+		assert.ErrorContains(t, err, "selectors_aptos.yml")
+
+		_, err = LoadSnapshot(filepath.Join(dir, "missing"))
+		assert.Error(t, err)
+	})
+}
+
+func TestCompare(t *testing.T) {
+	details := func(selector uint64, name string) chain_selectors.ChainDetails {
+		return chain_selectors.ChainDetails{ChainSelector: selector, ChainName: name}
+	}
+	previous := Snapshot{
+		chain_selectors.FamilyEVM: {
+			"1":  details(1, "ethereum-mainnet"),
+			"10": details(10, "optimism-mainnet"),
+			"56": details(56, "bsc-mainnet"),
+		},
+		chain_selectors.FamilySolana: {
+			"genesis": details(100, "solana-mainnet"),
+			"devnet":  details(101, "solana-devnet"),
+		},
+		chain_selectors.FamilyAptos: {
+			"1": details(200, "aptos-mainnet"),
+		},
+	}
+
+	t.Run("unchanged", func(t *testing.T) {
+		report := Compare(previous, previous)
+		assert.Empty(t, report.Changes)
+		assert.False(t, report.HasViolations())
+	})
+
+	t.Run("added chains are allowed", func(t *testing.T) {
+		current := Snapshot{
+			chain_selectors.FamilyEVM:    previous[chain_selectors.FamilyEVM],
+			chain_selectors.FamilySolana: previous[chain_selectors.FamilySolana],
+			chain_selectors.FamilyAptos:  previous[chain_selectors.FamilyAptos],
+			chain_selectors.FamilyCosmos: {"cosmoshub-4": details(300, "cosmoshub-mainnet")},
+		}
+		assert.Empty(t, Compare(previous, current).Changes)
+	})
+
+	t.Run("changes across families", func(t *testing.T) {
+		current := Snapshot{
+			chain_selectors.FamilyEVM: {
+				"1":  details(1, "ethereum-mainnet"),
+				"10": details(11, "optimism-mainnet"),
+				"56": details(56, "bnb-mainnet"),
+				"99": details(101, "reusing-chain"),
+			},
+			chain_selectors.FamilySolana: {
+				"genesis": details(100, "solana-mainnet"),
+				"devnet":  details(101, "solana-devnet"),
+			},
+			chain_selectors.FamilySui: {
+				"1": details(200, "sui-mainnet"),
+			},
+		}
+
+		report := Compare(previous, current)
+		kinds := make(map[Kind]Change)
+		for _, change := range report.Changes {
+			kinds[change.Kind] = change
+		}
+		require.Len(t, report.Changes, 4)
+
+		assert.Equal(t, chain_selectors.FamilySui, kinds[KindSelectorMoved].NewFamily)
+		assert.Equal(t, "selector 200 moved from aptos chain 1 to sui chain 1", kinds[KindSelectorMoved].Message)
+		assert.Equal(t, "evm chain 10 changed selector from 10 to 11", kinds[KindSelectorModified].Message)
+		assert.Equal(t, uint64(11), kinds[KindSelectorModified].NewSelector)
+		assert.Equal(t, `evm chain 56 changed name from "bsc-mainnet" to "bnb-mainnet"`, kinds[KindNameChanged].Message)
+		assert.Equal(t, "selector 101 of solana chain devnet is reused by new evm chain 99", kinds[KindSelectorReused].Message)
+
+		assert.True(t, report.HasViolations())
+		assert.Len(t, report.Violations(), 3)
+	})
+
+	t.Run("removed chain", func(t *testing.T) {
+		current := Snapshot{
+			chain_selectors.FamilyEVM:    previous[chain_selectors.FamilyEVM],
+			chain_selectors.FamilySolana: previous[chain_selectors.FamilySolana],
+		}
+		report := Compare(previous, current)
+		require.Len(t, report.Changes, 1)
+		assert.Equal(t, KindChainRemoved, report.Changes[0].Kind)
+		assert.Equal(t, "aptos chain 1 with selector 200 was removed", report.Changes[0].Message)
+	})
+
+	t.Run("name changes are not violations", func(t *testing.T) {
+		current := Snapshot{
+			chain_selectors.FamilyEVM: {
+				"1":  details(1, "ethereum-mainnet"),
+				"10": details(10, "op-mainnet"),
+				"56": details(56, "bsc-mainnet"),
+			},
+			chain_selectors.FamilySolana: previous[chain_selectors.FamilySolana],
+			chain_selectors.FamilyAptos:  previous[chain_selectors.FamilyAptos],
+		}
+		report := Compare(previous, current)
+		require.Len(t, report.Changes, 1)
+		assert.False(t, report.HasViolations())
+	})
+}
@@ -0,0 +1,245 @@
+// Package lint checks that the selector files follow the conventions of the README: chain names follow
+// the naming convention, network types agree with the names and chains are sorted by chain ID.
+package lint
+
+import (
+	"bufio"
+	"bytes"
+	"cmp"
+	_ "embed"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	chain_selectors "github.com/smartcontractkit/chain-selectors"
+	"gopkg.in/yaml.v3"
+)
+
+//go:embed legacy_names.txt
+var legacyNames []byte
+
+// legacyPatternNames holds the names of the existing chains that may follow the legacy naming pattern
+//
+//go:embed legacy_pattern_names.txt
+var legacyPatternNames []byte
+
+// legacyPattern is the set of names in legacyPatternNames
+var legacyPattern, _ = parseAllowlist(legacyPatternNames)
+
+// selectorsFile is a selector file of a family.
+type selectorsFile struct {
+	name   string
+	family string
+	// sorted is set for files whose chains must be sorted by chain id within their sections. Files of other
+	// families list their few chains from mainnet to local networks.
+	sorted bool
+}
+
+// selectorsFiles lists the selector files of the repository in the order they are checked
+var selectorsFiles = []selectorsFile{
+	{name: "selectors.yml", family: chain_selectors.FamilyEVM, sorted: true},
+	{name: "test_selectors.yml", family: chain_selectors.FamilyEVM, sorted: true},
+	{name: "selectors_solana.yml", family: chain_selectors.FamilySolana},
+	{name: "test_selectors_solana.yml", family: chain_selectors.FamilySolana},
+	{name: "selectors_aptos.yml", family: chain_selectors.FamilyAptos},
+	{name: "selectors_sui.yml", family: chain_selectors.FamilySui},
+	{name: "selectors_ton.yml", family: chain_selectors.FamilyTon},
+	{name: "selectors_tron.yml", family: chain_selectors.FamilyTron},
+	{name: "selectors_starknet.yml", family: chain_selectors.FamilyStarknet},
+	{name: "selectors_canton.yml", family: chain_selectors.FamilyCanton},
+	{name: "selectors_stellar.yml", family: chain_selectors.FamilyStellar},
+	{name: "selectors_cosmos.yml", family: chain_selectors.FamilyCosmos},
+}
+
+// Rule is the rule broken by an issue.
+type Rule string
+
+const (
+	// RuleName is a chain name that doesn't follow the naming convention
+	RuleName Rule = "name"
+	// RuleNetworkType is a network_type that doesn't agree with the type of the chain name
+	RuleNetworkType Rule = "network_type"
+	// RuleOrder is a chain that is not sorted by chain ID within its section of selectors.yml
+	RuleOrder Rule = "order"
+)
+
+// Issue is a chain of a selector file that breaks a rule.
+type Issue struct {
+	Rule    Rule   `json:"rule"`
+	File    string `json:"file"`
+	Line    int    `json:"line"`
+	Family  string `json:"family"`
+	ChainID string `json:"chain_id"`
+	Name    string `json:"name"`
+	Message string `json:"message"`
+}
+
+// Report holds the issues of the selector files sorted by file and line.
+type Report struct {
+	Issues []Issue `json:"issues"`
+}
+
+// Allowlist holds the grandfathered chain names that are not checked against the naming convention.
+type Allowlist map[string]bool
+
+// DefaultAllowlist returns the names of the chains added before the naming convention, see legacy_names.txt.
+func DefaultAllowlist() Allowlist {
+	allowlist, _ := parseAllowlist(legacyNames)
+	return allowlist
+}
+
+// LoadAllowlist loads an allowlist file holding a chain name per line. Empty lines and lines starting
+// with # are ignored.
+func LoadAllowlist(path string) (Allowlist, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return parseAllowlist(content)
+}
+
+func parseAllowlist(content []byte) (Allowlist, error) {
+	allowlist := make(Allowlist)
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		allowlist[line] = true
+	}
+	return allowlist, scanner.Err()
+}
+
+// entry is a chain of a selector file
+type entry struct {
+	chainID string
+	line    int
+	// section is set on the first chain of a section, which starts at a comment, e.g. "# Mainnets"
+	section bool
+	details chain_selectors.ChainDetails
+}
+
+// Lint checks the selector files of every family in dir, e.g. a checkout of this repository.
+// Missing files are skipped. Names in allowlist are not checked against the naming convention, and the existing
+// chains listed in legacy_pattern_names.txt may follow the legacy naming pattern.
+func Lint(dir string, allowlist Allowlist) (Report, error) {
+	var issues []Issue
+	for _, file := range selectorsFiles {
+		path := filepath.Join(dir, file.name)
+		content, err := os.ReadFile(path)
+		if errors.Is(err, os.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return Report{}, err
+		}
+		entries, err := readEntries(content)
+		if err != nil {
+			return Report{}, fmt.Errorf("failed to parse %s: %w", path, err)
+		}
+		issues = append(issues, lintFile(file, entries, allowlist)...)
+	}
+
+	sort.SliceStable(issues, func(i, j int) bool {
+		if issues[i].File != issues[j].File {
+			return issues[i].File < issues[j].File
+		}
+		return issues[i].Line < issues[j].Line
+	})
+	return Report{Issues: issues}, nil
+}
+
+// readEntries returns the chains of a selector file in the order they are listed.
+func readEntries(content []byte) ([]entry, error) {
+	var root yaml.Node
+	if err := yaml.Unmarshal(content, &root); err != nil {
+		return nil, err
+	}
+	if len(root.Content) == 0 || root.Content[0].Kind != yaml.MappingNode {
+		return nil, errors.New("expected a selectors mapping")
+	}
+
+	var entries []entry
+	document := root.Content[0]
+	for i := 0; i+1 < len(document.Content); i += 2 {
+		if document.Content[i].Value != "selectors" {
+			continue
+		}
+		chains := document.Content[i+1]
+		if chains.Kind != yaml.MappingNode {
+			return nil, errors.New("expected selectors to be a mapping")
+		}
+		for j := 0; j+1 < len(chains.Content); j += 2 {
+			key := chains.Content[j]
+			var details chain_selectors.ChainDetails
+			if err := chains.Content[j+1].Decode(&details); err != nil {
+				return nil, fmt.Errorf("chain %s: %w", key.Value, err)
+			}
+			entries = append(entries, entry{chainID: key.Value, line: key.Line, section: key.HeadComment != "", details: details})
+		}
+	}
+	return entries, nil
+}
+
+func lintFile(file selectorsFile, entries []entry, allowlist Allowlist) []Issue {
+	var issues []Issue
+	issue := func(rule Rule, e entry, format string, args ...any) {
+		issues = append(issues, Issue{
+			Rule:    rule,
+			File:    file.name,
+			Line:    e.line,
+			Family:  file.family,
+			ChainID: e.chainID,
+			Name:    e.details.ChainName,
+			Message: fmt.Sprintf("%s:%d: %s", file.name, e.line, fmt.Sprintf(format, args...)),
+		})
+	}
+
+	for i, e := range entries {
+		if file.sorted && i > 0 && !e.section && compareChainIDs(entries[i-1].chainID, e.chainID) > 0 {
+			issue(RuleOrder, e, "chain %s is listed after chain %s, chains must be sorted by chain id within their section",
+				e.chainID, entries[i-1].chainID)
+		}
+
+		// Names are optional
+		name := e.details.ChainName
+		if name == "" {
+			continue
+		}
+		if !allowlist[name] {
+			for _, err := range chain_selectors.ValidateChainName(name) {
+				if errors.Is(err, chain_selectors.ErrLegacyChainName) && legacyPattern[name] {
+					continue
+				}
+				issue(RuleName, e, "%v", err)
+			}
+		}
+		parsed, err := chain_selectors.ParseChainName(name)
+		if err != nil || e.details.NetworkType == "" {
+			continue
+		}
+		expected := chain_selectors.NetworkTypeTestnet
+		if parsed.Type == string(chain_selectors.NetworkTypeMainnet) {
+			expected = chain_selectors.NetworkTypeMainnet
+		}
+		if e.details.NetworkType != expected {
+			issue(RuleNetworkType, e, "chain %s has network_type %s but its name %s is a %s", e.chainID, e.details.NetworkType, name, parsed.Type)
+		}
+	}
+	return issues
+}
+
+// compareChainIDs compares EVM chain IDs by value.
+func compareChainIDs(a, b string) int {
+	x, errA := strconv.ParseUint(a, 10, 64)
+	y, errB := strconv.ParseUint(b, 10, 64)
+	if errA != nil || errB != nil {
+		return strings.Compare(a, b)
+	}
+	return cmp.Compare(x, y)
+}
@@ -0,0 +1,131 @@
+package lint
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeFile(t *testing.T, dir, name, content string) {
+	t.Helper()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0o600))
+}
+
+func TestLintRepository(t *testing.T) {
+	report, err := Lint("..", DefaultAllowlist())
+	require.NoError(t, err)
+	assert.Empty(t, report.Issues)
+
+	report, err = Lint("..", Allowlist{})
+	require.NoError(t, err)
+	assert.NotEmpty(t, report.Issues)
+	for _, issue := range report.Issues {
+		assert.Equal(t, RuleName, issue.Rule, issue.Message)
+		assert.True(t, DefaultAllowlist()[issue.Name], issue.Message)
+	}
+}
+
+func TestLint(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, dir, "selectors.yml", `selectors:
+  # Testnets
+  11155111:
+    selector: 16015286601757825753
+    name: ethereum-testnet-sepolia
+    network_type: testnet
+  97:
+    selector: 13264668187771770619
+    name: binance_smart_chain-testnet
+    network_type: testnet
+  # Mainnets
+  1:
+    selector: 5009297550715157269
+    name: ethereum-mainnet
+    network_type: testnet
+  10:
+    selector: 3734403246176062136
+    name: ethereum-mainnet-optimism-1
+    network_type: mainnet
+  12:
+    selector: 1
+    network_type: mainnet
+`)
+	writeFile(t, dir, "selectors_sui.yml", `selectors:
+  1:
+    name: ethereum-mainnet-arbitrum-1
+    selector: 4741433654826277614
+    network_type: testnet
+  2:
+    name: ethereum-mainnet-newrollup-1
+    selector: 9762610643973837292
+    network_type: mainnet
+`)
+	writeFile(t, dir, "selectors_ton.yml", `selectors:
+  -239:
+    name: ton-mainnet
+    selector: 16448340667252469081
+    network_type: mainnet
+  -3:
+    name: ton_-testnet
+    selector: 1399300952838017768
+    network_type: testnet
+`)
+
+	report, err := Lint(dir, Allowlist{"ethereum-mainnet-optimism-1": true})
+	require.NoError(t, err)
+
+	var messages []string
+	for _, issue := range report.Issues {
+		messages = append(messages, issue.Message)
+	}
+	assert.Equal(t, []string{
+		"selectors.yml:7: chain 97 is listed after chain 11155111, chains must be sorted by chain id within their section",
+		"selectors.yml:7: chain name binance_smart_chain-testnet has no network instance, which testnet names must have",
+		"selectors.yml:12: chain 1 has network_type testnet but its name ethereum-mainnet is a mainnet",
+		// Existing chains may follow the legacy naming pattern, but are checked against every other rule
+		"selectors_sui.yml:2: chain 1 has network_type testnet but its name ethereum-mainnet-arbitrum-1 is a mainnet",
+		"selectors_sui.yml:6: chain name ethereum-mainnet-newrollup-1 follows the legacy naming pattern",
+		"selectors_ton.yml:6: chain name ton_-testnet has component ton_ which is not snake_case",
+		"selectors_ton.yml:6: chain name ton_-testnet has no network instance, which testnet names must have",
+	}, messages)
+	assert.Equal(t, Issue{
+		Rule:    RuleNetworkType,
+		File:    "selectors.yml",
+		Line:    12,
+		Family:  "evm",
+		ChainID: "1",
+		Name:    "ethereum-mainnet",
+		Message: messages[2],
+	}, report.Issues[2])
+}
+
+func TestLintErrors(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, dir, "selectors_sui.yml", "selectors: [")
+	_, err := Lint(dir, nil)
+	assert.ErrorContains(t, err, "selectors_sui.yml")
+
+	writeFile(t, dir, "selectors_sui.yml", "selectors:\n  1:\n    selector: abc\n")
+	_, err = Lint(dir, nil)
+	assert.ErrorContains(t, err, "chain 1")
+}
+
+func TestLoadAllowlist(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, dir, "allowlist.txt", "# Legacy names\n\nethereum-mainnet-arbitrum-1\n  xdc-testnet  \n")
+
+	allowlist, err := LoadAllowlist(filepath.Join(dir, "allowlist.txt"))
+	require.NoError(t, err)
+	assert.Equal(t, Allowlist{"ethereum-mainnet-arbitrum-1": true, "xdc-testnet": true}, allowlist)
+
+	_, err = LoadAllowlist(filepath.Join(dir, "missing"))
+	assert.Error(t, err)
+
+	assert.True(t, DefaultAllowlist()["xdc-testnet"])
+	assert.False(t, DefaultAllowlist()["ethereum-mainnet"])
+	assert.False(t, DefaultAllowlist()["ethereum-mainnet-arbitrum-1"], "legacy pattern names are only exempted from the legacy pattern rule")
+	assert.True(t, legacyPattern["ethereum-mainnet-arbitrum-1"])
+}
@@ -0,0 +1,465 @@
+package chain_selectors
+
+import (
+	"sort"
+	"strconv"
+	"sync"
+	"sync/atomic"
+)
+
+// Registry indexes chains of every family by selector, by family and chain ID, and by name.
+// The package level functions are backed by a default registry holding the embedded selectors
+// and any selectors loaded from EXTRA_SELECTORS_FILE. Use NewRegistry or NewRegistryFromData
+// to work with an isolated set of chains.
+//
+// Lookups read an immutable snapshot without locking. Writers, e.g. Register or MergeExtraSelectors,
+// publish a modified copy of the snapshot, so readers never observe a partial update.
+type Registry struct {
+	// writeLock serializes writers
+	writeLock sync.Mutex
+	current   atomic.Pointer[registrySnapshot]
+}
+
+// registrySnapshot holds the indexes of a registry. A published snapshot is never modified.
+type registrySnapshot struct {
+	bySelector map[uint64]chainEntry
+	byChainID  map[string]map[string]uint64 // family -> chain ID -> selector
+	byName     map[string]uint64
+}
+
+// chainEntry is a single chain as stored in the registry. ChainID is formatted the same way
+// GetChainIDFromSelector returns it, e.g. decimal for numeric chain IDs.
+type chainEntry struct {
+	Family       string
+	ChainID      string
+	ChainDetails ChainDetails
+}
+
+// name returns the chain name, falling back to the chain ID for unnamed chains.
+func (e chainEntry) name() string {
+	if e.ChainDetails.ChainName == "" {
+		return e.ChainID
+	}
+	return e.ChainDetails.ChainName
+}
+
+// chain returns the family specific chain struct for the entry.
+func (e chainEntry) chain() ChainInfo {
+	switch e.Family {
+	case FamilyEVM:
+		return evmChainFromEntry(e)
+	case FamilySolana:
+		return solanaChainFromEntry(e)
+	case FamilyAptos:
+		return aptosChainFromEntry(e)
+	case FamilySui:
+		return suiChainFromEntry(e)
+	case FamilyTron:
+		return tronChainFromEntry(e)
+	case FamilyTon:
+		return tonChainFromEntry(e)
+	case FamilyStarknet:
+		return starknetChainFromEntry(e)
+	case FamilyCanton:
+		return cantonChainFromEntry(e)
+	case FamilyStellar:
+		return stellarChainFromEntry(e)
+	case FamilyCosmos:
+		return cosmosChainFromEntry(e)
+	default:
+		return nil
+	}
+}
+
+func (e chainEntry) uintChainID() uint64 {
+	chainID, _ := strconv.ParseUint(e.ChainID, 10, 64)
+	return chainID
+}
+
+func (e chainEntry) intChainID() int32 {
+	chainID, _ := strconv.ParseInt(e.ChainID, 10, 32)
+	return int32(chainID)
+}
+
+var defaultRegistry = newDefaultRegistry()
+
+func newDefaultRegistry() *Registry {
+	r := NewEmbeddedRegistry()
+	report, err := r.MergeExtraSelectors(getExtraSelectors(), extraSelectorsConflictPolicy())
+	if err != nil {
+		handleExtraSelectorsError(err)
+	}
+	extraSelectorsReport = report
+	return r
+}
+
+// DefaultRegistry returns the registry backing the package level functions.
+func DefaultRegistry() *Registry {
+	return defaultRegistry
+}
+
+// NewRegistry returns an empty registry.
+func NewRegistry() *Registry {
+	return newRegistryFromSnapshot(newRegistrySnapshot())
+}
+
+// NewRegistryFromData returns a registry holding only the chains in data.
+func NewRegistryFromData(data ExtraSelectorsData) *Registry {
+	s := newRegistrySnapshot()
+	data.ForEach(func(family, chainID string, details ChainDetails) {
+		s.add(chainEntry{Family: family, ChainID: chainID, ChainDetails: details})
+	})
+	return newRegistryFromSnapshot(s)
+}
+
+func newRegistryFromSnapshot(s *registrySnapshot) *Registry {
+	r := &Registry{}
+	r.current.Store(s)
+	return r
+}
+
+// NewEmbeddedRegistry returns a registry holding the selectors embedded in this package,
+// without any selectors loaded from EXTRA_SELECTORS_FILE.
+func NewEmbeddedRegistry() *Registry {
+	return newRegistryFromSnapshot(newEmbeddedSnapshot())
+}
+
+// newEmbeddedSnapshot indexes the generated tables of the embedded selectors, see genselectors.go.
+func newEmbeddedSnapshot() *registrySnapshot {
+	size := 0
+	for _, chains := range embeddedChains {
+		size += len(chains)
+	}
+	s := &registrySnapshot{
+		bySelector: make(map[uint64]chainEntry, size),
+		byChainID:  make(map[string]map[string]uint64),
+		byName:     make(map[string]uint64, size),
+	}
+	for _, chains := range embeddedChains {
+		for _, e := range chains {
+			s.add(e)
+		}
+	}
+	return s
+}
+
+// Clone returns an independent copy of the registry.
+func (r *Registry) Clone() *Registry {
+	return newRegistryFromSnapshot(r.snapshot().clone())
+}
+
+// snapshot returns the current state of the registry. It must not be modified.
+func (r *Registry) snapshot() *registrySnapshot {
+	return r.current.Load()
+}
+
+// update publishes a copy of the current snapshot modified by fn. Nothing is published if fn fails.
+func (r *Registry) update(fn func(s *registrySnapshot) error) error {
+	r.writeLock.Lock()
+	defer r.writeLock.Unlock()
+
+	next := r.snapshot().clone()
+	if err := fn(next); err != nil {
+		return err
+	}
+	r.current.Store(next)
+	return nil
+}
+
+func newRegistrySnapshot() *registrySnapshot {
+	return &registrySnapshot{
+		bySelector: make(map[uint64]chainEntry),
+		byChainID:  make(map[string]map[string]uint64),
+		byName:     make(map[string]uint64),
+	}
+}
+
+// clone returns a copy of s that can be modified before it is published.
+func (s *registrySnapshot) clone() *registrySnapshot {
+	c := &registrySnapshot{
+		bySelector: make(map[uint64]chainEntry, len(s.bySelector)),
+		byChainID:  make(map[string]map[string]uint64, len(s.byChainID)),
+		byName:     make(map[string]uint64, len(s.byName)),
+	}
+	for selector, e := range s.bySelector {
+		c.bySelector[selector] = e
+	}
+	for family, chains := range s.byChainID {
+		c.byChainID[family] = make(map[string]uint64, len(chains))
+		for chainID, selector := range chains {
+			c.byChainID[family][chainID] = selector
+		}
+	}
+	for name, selector := range s.byName {
+		c.byName[name] = selector
+	}
+	return c
+}
+
+func (s *registrySnapshot) add(e chainEntry) {
+	if _, exists := s.byChainID[e.Family]; !exists {
+		s.byChainID[e.Family] = make(map[string]uint64)
+	}
+	s.bySelector[e.ChainDetails.ChainSelector] = e
+	s.byChainID[e.Family][e.ChainID] = e.ChainDetails.ChainSelector
+	if e.ChainDetails.ChainName != "" {
+		if _, exists := s.byName[e.ChainDetails.ChainName]; !exists {
+			s.byName[e.ChainDetails.ChainName] = e.ChainDetails.ChainSelector
+		}
+	}
+}
+
+func (s *registrySnapshot) remove(e chainEntry) {
+	delete(s.bySelector, e.ChainDetails.ChainSelector)
+	delete(s.byChainID[e.Family], e.ChainID)
+	if selector, exists := s.byName[e.ChainDetails.ChainName]; exists && selector == e.ChainDetails.ChainSelector {
+		delete(s.byName, e.ChainDetails.ChainName)
+	}
+}
+
+func (s *registrySnapshot) entryByChainID(family, chainID string) (chainEntry, bool) {
+	selector, exists := s.byChainID[family][chainID]
+	if !exists {
+		return chainEntry{}, false
+	}
+	return s.bySelector[selector], true
+}
+
+// Register adds a chain to the registry, e.g. a devnet started by a test. The chain ID must not exist
+// for the family and the selector and name must not be used by any other chain. The chain is validated
+// like an entry of an extra selectors file.
+func (r *Registry) Register(family, chainID string, details ChainDetails) error {
+	normalized, err := normalizeChainID(chainID, family)
+	if err != nil {
+		return err
+	}
+	if details.ChainSelector == 0 {
+		return newLookupError(ErrInvalidSelector, nil, "invalid chain selector for %s chain %s: must be > 0", family, chainID)
+	}
+
+	var data ExtraSelectorsData
+	data.Set(family, normalized, details)
+	if errs := validateExtraSelectors(&data); len(errs) > 0 {
+		return errs[0]
+	}
+
+	return r.update(func(s *registrySnapshot) error {
+		if _, exists := s.byChainID[family][normalized]; exists {
+			return newLookupError(ErrChainExists, nil, "%s chain %s already exists", family, chainID)
+		}
+		if other, exists := s.bySelector[details.ChainSelector]; exists {
+			return newLookupError(ErrSelectorInUse, nil, "selector %d is already used by %s chain %s",
+				details.ChainSelector, other.Family, other.ChainID)
+		}
+		if other, exists := s.entryByName(details.ChainName); exists && details.ChainName != "" {
+			return newLookupError(ErrNameInUse, nil, "name %s is already used by %s chain %s",
+				details.ChainName, other.Family, other.ChainID)
+		}
+		s.add(chainEntry{Family: family, ChainID: normalized, ChainDetails: details})
+		return nil
+	})
+}
+
+// Unregister removes a chain from the registry.
+func (r *Registry) Unregister(family, chainID string) error {
+	normalized, err := normalizeChainID(chainID, family)
+	if err != nil {
+		return err
+	}
+
+	return r.update(func(s *registrySnapshot) error {
+		e, exists := s.entryByChainID(family, normalized)
+		if !exists {
+			return newLookupError(ErrUnknownChainID{Family: family, ChainID: chainID}, nil, "%s chain %s is not registered", family, chainID)
+		}
+		s.remove(e)
+		return nil
+	})
+}
+
+func (r *Registry) entry(selector uint64) (chainEntry, bool) {
+	e, exists := r.snapshot().bySelector[selector]
+	return e, exists
+}
+
+func (r *Registry) entryByChainID(family, chainID string) (chainEntry, bool) {
+	return r.snapshot().entryByChainID(family, chainID)
+}
+
+func (r *Registry) entryByName(name string) (chainEntry, bool) {
+	return r.snapshot().entryByName(name)
+}
+
+func (s *registrySnapshot) entryByName(name string) (chainEntry, bool) {
+	selector, exists := s.byName[name]
+	if !exists {
+		return chainEntry{}, false
+	}
+	return s.bySelector[selector], true
+}
+
+// familyEntries returns all chains of the given family sorted by selector.
+func (r *Registry) familyEntries(family string) []chainEntry {
+	s := r.snapshot()
+	entries := make([]chainEntry, 0, len(s.byChainID[family]))
+	for _, selector := range s.byChainID[family] {
+		entries = append(entries, s.bySelector[selector])
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].ChainDetails.ChainSelector < entries[j].ChainDetails.ChainSelector
+	})
+	return entries
+}
+
+func (r *Registry) getChainEntry(selector uint64) (chainEntry, error) {
+	e, exists := r.entry(selector)
+	if !exists {
+		return chainEntry{}, newLookupError(ErrUnknownSelector, nil, "unknown chain selector %d", selector)
+	}
+	return e, nil
+}
+
+// GetChain returns the family specific chain struct of the chain with the given selector.
+func (r *Registry) GetChain(selector uint64) (ChainInfo, error) {
+	e, err := r.getChainEntry(selector)
+	if err != nil {
+		return nil, err
+	}
+	return e.chain(), nil
+}
+
+// GetSelectorFamily returns the family of the chain with the given selector.
+func (r *Registry) GetSelectorFamily(selector uint64) (string, error) {
+	e, err := r.getChainEntry(selector)
+	if err != nil {
+		return "", err
+	}
+	return e.Family, nil
+}
+
+// GetChainIDFromSelector returns the chain ID of the chain with the given selector.
+func (r *Registry) GetChainIDFromSelector(selector uint64) (string, error) {
+	e, err := r.getChainEntry(selector)
+	if err != nil {
+		return "", err
+	}
+	return e.ChainID, nil
+}
+
+// GetChainNameFromSelector returns the name of the chain with the given selector.
+func (r *Registry) GetChainNameFromSelector(selector uint64) (string, error) {
+	e, err := r.getChainEntry(selector)
+	if err != nil {
+		return "", err
+	}
+	return e.ChainDetails.ChainName, nil
+}
+
+// GetChainDetails returns the chain details of the chain with the given selector.
+func (r *Registry) GetChainDetails(selector uint64) (ChainDetails, error) {
+	e, err := r.getChainEntry(selector)
+	if err != nil {
+		return ChainDetails{}, err
+	}
+	return e.ChainDetails, nil
+}
+
+// GetNetworkType returns the network type of the chain with the given selector.
+func (r *Registry) GetNetworkType(selector uint64) (NetworkType, error) {
+	e, err := r.getChainEntry(selector)
+	if err != nil {
+		return "", err
+	}
+	return e.ChainDetails.NetworkType, nil
+}
+
+// GetChainDetailsByNetworkName returns chain details for the given network name.
+func (r *Registry) GetChainDetailsByNetworkName(networkName string) (ChainDetails, error) {
+	s := r.snapshot()
+	e, exists := s.entryByName(networkName)
+	if !exists {
+		return ChainDetails{}, newUnknownNameError(s, networkName, func(chainEntry) bool { return true },
+			"chain details not found for network name %s", networkName)
+	}
+	return e.ChainDetails, nil
+}
+
+// GetChainDetailsByChainIDAndFamily returns chain details for the given chain ID of the given family.
+func (r *Registry) GetChainDetailsByChainIDAndFamily(chainID string, family string) (ChainDetails, error) {
+	normalized, err := normalizeChainID(chainID, family)
+	if err != nil {
+		return ChainDetails{}, err
+	}
+
+	e, exists := r.entryByChainID(family, normalized)
+	if !exists {
+		return ChainDetails{}, newLookupError(ErrUnknownChainID{Family: family, ChainID: chainID}, nil, "invalid chain id %s for %s", chainID, family)
+	}
+	return e.ChainDetails, nil
+}
+
+// ChainIdToChainSelector returns a copy of the chain ID to selector mapping of the given family.
+func (r *Registry) ChainIdToChainSelector(family string) map[string]uint64 {
+	chains := r.snapshot().byChainID[family]
+	copyMap := make(map[string]uint64, len(chains))
+	for k, v := range chains {
+		copyMap[k] = v
+	}
+	return copyMap
+}
+
+// normalizeChainID validates the chain ID for the family and formats it the way the registry stores it.
+func normalizeChainID(chainID string, family string) (string, error) {
+	switch family {
+	case FamilyEVM, FamilyAptos, FamilySui, FamilyTron:
+		id, err := strconv.ParseUint(chainID, 10, 64)
+		if err != nil {
+			return "", newLookupError(ErrInvalidChainID, err, "invalid chain id %s for %s", chainID, family)
+		}
+		return strconv.FormatUint(id, 10), nil
+	case FamilyTon:
+		id, err := strconv.ParseInt(chainID, 10, 32)
+		if err != nil {
+			return "", newLookupError(ErrInvalidChainID, err, "invalid chain id %s for %s", chainID, family)
+		}
+		return strconv.FormatInt(id, 10), nil
+	case FamilySolana, FamilyStarknet, FamilyCanton, FamilyStellar, FamilyCosmos:
+		return chainID, nil
+	default:
+		return "", newLookupError(ErrUnsupportedFamily, nil, "family %s is not yet supported", family)
+	}
+}
+
+// ForEach calls fn for every chain in data with its chain ID formatted as a string.
+func (data ExtraSelectorsData) ForEach(fn func(family, chainID string, details ChainDetails)) {
+	for k, v := range data.Evm {
+		fn(FamilyEVM, strconv.FormatUint(k, 10), v)
+	}
+	for k, v := range data.Solana {
+		fn(FamilySolana, k, v)
+	}
+	for k, v := range data.Aptos {
+		fn(FamilyAptos, strconv.FormatUint(k, 10), v)
+	}
+	for k, v := range data.Sui {
+		fn(FamilySui, strconv.FormatUint(k, 10), v)
+	}
+	for k, v := range data.Tron {
+		fn(FamilyTron, strconv.FormatUint(k, 10), v)
+	}
+	for k, v := range data.Ton {
+		fn(FamilyTon, strconv.FormatInt(int64(k), 10), v)
+	}
+	for k, v := range data.Starknet {
+		fn(FamilyStarknet, k, v)
+	}
+	for k, v := range data.Canton {
+		fn(FamilyCanton, k, v)
+	}
+	for k, v := range data.Stellar {
+		fn(FamilyStellar, k, v)
+	}
+	for k, v := range data.Cosmos {
+		fn(FamilyCosmos, k, v)
+	}
+}
@@ -0,0 +1,200 @@
+package chain_selectors
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewRegistryFromData(t *testing.T) {
+	r := NewRegistryFromData(ExtraSelectorsData{
+		Evm: map[uint64]ChainDetails{
+			1337: {ChainSelector: 111, ChainName: "test-evm-chain", NetworkType: NetworkTypeTestnet},
+		},
+		Ton: map[int32]ChainDetails{
+			-42: {ChainSelector: 222, ChainName: "test-ton-chain", NetworkType: NetworkTypeTestnet},
+		},
+		Solana: map[string]ChainDetails{
+			"TestGenesisHash": {ChainSelector: 333, ChainName: "test-solana-chain", NetworkType: NetworkTypeMainnet},
+		},
+	})
+
+	t.Run("lookup by selector", func(t *testing.T) {
+		family, err := r.GetSelectorFamily(222)
+		require.NoError(t, err)
+		assert.Equal(t, FamilyTon, family)
+
+		chainID, err := r.GetChainIDFromSelector(222)
+		require.NoError(t, err)
+		assert.Equal(t, "-42", chainID)
+
+		name, err := r.GetChainNameFromSelector(333)
+		require.NoError(t, err)
+		assert.Equal(t, "test-solana-chain", name)
+
+		networkType, err := r.GetNetworkType(333)
+		require.NoError(t, err)
+		assert.Equal(t, NetworkTypeMainnet, networkType)
+	})
+
+	t.Run("lookup by chain id and family", func(t *testing.T) {
+		details, err := r.GetChainDetailsByChainIDAndFamily("1337", FamilyEVM)
+		require.NoError(t, err)
+		assert.Equal(t, uint64(111), details.ChainSelector)
+
+		_, err = r.GetChainDetailsByChainIDAndFamily("1337", FamilySolana)
+		assert.ErrorContains(t, err, "invalid chain id 1337 for solana")
+
+		_, err = r.GetChainDetailsByChainIDAndFamily("abc", FamilyEVM)
+		assert.ErrorContains(t, err, "invalid chain id abc for evm")
+
+		_, err = r.GetChainDetailsByChainIDAndFamily("1", "unknown")
+		assert.ErrorContains(t, err, "family unknown is not yet supported")
+	})
+
+	t.Run("lookup by name", func(t *testing.T) {
+		details, err := r.GetChainDetailsByNetworkName("test-evm-chain")
+		require.NoError(t, err)
+		assert.Equal(t, uint64(111), details.ChainSelector)
+
+		_, err = r.GetChainDetailsByNetworkName("ethereum-mainnet")
+		assert.ErrorContains(t, err, "chain details not found for network name ethereum-mainnet")
+	})
+
+	t.Run("isolated from the default registry", func(t *testing.T) {
+		_, err := r.GetChainDetails(ETHEREUM_MAINNET.Selector)
+		assert.ErrorContains(t, err, "unknown chain selector")
+
+		_, err = GetChainDetails(111)
+		assert.ErrorContains(t, err, "unknown chain selector")
+	})
+
+	t.Run("chain id to selector mapping", func(t *testing.T) {
+		assert.Equal(t, map[string]uint64{"-42": 222}, r.ChainIdToChainSelector(FamilyTon))
+		assert.Empty(t, r.ChainIdToChainSelector(FamilyAptos))
+	})
+}
+
+func TestRegistryClone(t *testing.T) {
+	clone := DefaultRegistry().Clone()
+	require.NoError(t, clone.Register(FamilyEVM, "424242424242", ChainDetails{ChainSelector: 424242, ChainName: "cloned-chain"}))
+
+	details, err := clone.GetChainDetails(ETHEREUM_MAINNET.Selector)
+	require.NoError(t, err)
+	assert.Equal(t, ETHEREUM_MAINNET.Name, details.ChainName)
+
+	_, err = clone.GetChainDetails(424242)
+	require.NoError(t, err)
+
+	_, err = GetChainDetails(424242)
+	assert.Error(t, err)
+}
+
+func TestEmbeddedRegistryMatchesDefault(t *testing.T) {
+	embedded := NewEmbeddedRegistry()
+	for _, ch := range ALL {
+		details, err := embedded.GetChainDetails(ch.Selector)
+		require.NoError(t, err)
+
+		expected, err := GetChainDetails(ch.Selector)
+		require.NoError(t, err)
+		assert.Equal(t, expected, details)
+	}
+}
+
+// embeddedSelectors returns the generated tables of the embedded selectors grouped by family.
+func embeddedSelectors() ExtraSelectorsData {
+	var data ExtraSelectorsData
+	for _, chains := range embeddedChains {
+		for _, e := range chains {
+			data.Set(e.Family, e.ChainID, e.ChainDetails)
+		}
+	}
+	return data
+}
+
+func TestEmbeddedSelectorsAreValid(t *testing.T) {
+	assert.Empty(t, Validate(embeddedSelectors()))
+
+	// Every embedded chain must be indexed, i.e. chain IDs and selectors are unique across the tables
+	size := 0
+	for _, chains := range embeddedChains {
+		size += len(chains)
+	}
+	s := NewEmbeddedRegistry().snapshot()
+	assert.Len(t, s.bySelector, size)
+	indexed := 0
+	for _, chains := range s.byChainID {
+		indexed += len(chains)
+	}
+	assert.Equal(t, size, indexed)
+}
+
+func TestRegistryRegister(t *testing.T) {
+	r := NewEmbeddedRegistry()
+	details := ChainDetails{ChainSelector: 1234567890123456789, ChainName: "test-anvil-devnet", NetworkType: NetworkTypeTestnet}
+
+	require.NoError(t, r.Register(FamilyEVM, "31337000", details))
+	got, err := r.GetChainDetails(1234567890123456789)
+	require.NoError(t, err)
+	assert.Equal(t, details, got)
+	chainID, err := r.GetChainIDFromSelector(1234567890123456789)
+	require.NoError(t, err)
+	assert.Equal(t, "31337000", chainID)
+	got, err = r.GetChainDetailsByNetworkName("test-anvil-devnet")
+	require.NoError(t, err)
+	assert.Equal(t, details, got)
+
+	err = r.Register(FamilyEVM, "31337000", ChainDetails{ChainSelector: 1234567890123456780})
+	assert.ErrorIs(t, err, ErrChainExists)
+	err = r.Register(FamilySolana, "ASwXBTzJM5evpfrWSHSjZaxPErZRuiGJnFixGUHi4NQT", ChainDetails{ChainSelector: 5009297550715157269})
+	assert.ErrorIs(t, err, ErrSelectorInUse)
+	assert.Contains(t, err.Error(), "evm chain 1")
+	err = r.Register(FamilySolana, "ASwXBTzJM5evpfrWSHSjZaxPErZRuiGJnFixGUHi4NQT", ChainDetails{ChainSelector: 1234567890123456780, ChainName: "ethereum-mainnet"})
+	assert.ErrorIs(t, err, ErrNameInUse)
+	assert.EqualError(t, err, "name ethereum-mainnet is already used by evm chain 1")
+	err = r.Register(FamilySolana, "not-base58-0OIl", ChainDetails{ChainSelector: 1234567890123456780})
+	assert.ErrorIs(t, err, ErrInvalidChainID)
+	err = r.Register(FamilyEVM, "abc", ChainDetails{ChainSelector: 1234567890123456780})
+	assert.ErrorIs(t, err, ErrInvalidChainID)
+	err = r.Register("not-a-family", "1", ChainDetails{ChainSelector: 1234567890123456780})
+	assert.ErrorIs(t, err, ErrUnsupportedFamily)
+	assert.Error(t, r.Register(FamilyEVM, "31337001", ChainDetails{}))
+
+	require.NoError(t, r.Unregister(FamilyEVM, "31337000"))
+	_, err = r.GetChainDetails(1234567890123456789)
+	assert.ErrorIs(t, err, ErrUnknownSelector)
+	_, err = r.GetChainDetailsByNetworkName("test-anvil-devnet")
+	assert.ErrorIs(t, err, ErrUnknownChainName)
+	assert.NotContains(t, r.ChainIdToChainSelector(FamilyEVM), "31337000")
+
+	var unknown ErrUnknownChainID
+	assert.ErrorAs(t, r.Unregister(FamilyEVM, "31337000"), &unknown)
+	assert.Equal(t, FamilyEVM, unknown.Family)
+
+	// The selector can be reused once the chain is unregistered
+	require.NoError(t, r.Register(FamilyTon, "-4242", details))
+	family, err := r.GetSelectorFamily(1234567890123456789)
+	require.NoError(t, err)
+	assert.Equal(t, FamilyTon, family)
+}
+
+func TestRegisterResolvesThroughPackageFunctions(t *testing.T) {
+	require.NoError(t, Register(FamilyEVM, "4242424242", ChainDetails{ChainSelector: 8888888888888888888, ChainName: "test-registered-chain"}))
+	t.Cleanup(func() {
+		require.NoError(t, Unregister(FamilyEVM, "4242424242"))
+	})
+
+	details, err := GetChainDetails(8888888888888888888)
+	require.NoError(t, err)
+	assert.Equal(t, "test-registered-chain", details.ChainName)
+
+	chain, exists := ChainByEvmChainID(4242424242)
+	require.True(t, exists)
+	assert.Equal(t, uint64(8888888888888888888), chain.Selector)
+
+	name, err := NameFromChainId(4242424242)
+	require.NoError(t, err)
+	assert.Equal(t, "test-registered-chain", name)
+}
@@ -0,0 +1,51 @@
+package remote
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"testing"
+	"time"
+
+	chain_selectors "github.com/smartcontractkit/chain-selectors"
+)
+
+// newBenchmarkClient returns a client whose cache holds size EVM chains with selectors and chain IDs
+// that are not embedded, so lookups are served from the remote data.
+func newBenchmarkClient(size int) *Client {
+	data := chain_selectors.ExtraSelectorsData{Evm: make(map[uint64]chain_selectors.ChainDetails, size)}
+	for i := 0; i < size; i++ {
+		data.Evm[uint64(9_000_000_000+i)] = chain_selectors.ChainDetails{
+			ChainSelector: uint64(9_000_000_000 + i),
+			ChainName:     "bench-remote-" + strconv.Itoa(i),
+		}
+	}
+
+	client := NewClient(WithCacheTTL(time.Hour))
+	client.cache.Store(newRemoteCacheData(data, nil))
+	return client
+}
+
+func BenchmarkClientLookups(b *testing.B) {
+	ctx := context.Background()
+	for _, size := range []int{100, 1_000, 10_000} {
+		client := newBenchmarkClient(size)
+		selector := uint64(9_000_000_000 + size - 1)
+		name := fmt.Sprintf("bench-remote-%d", size-1)
+
+		b.Run(fmt.Sprintf("GetChainDetailsBySelector/%d", size), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				if _, err := client.GetChainDetailsBySelector(ctx, selector); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+		b.Run(fmt.Sprintf("EvmChainIdFromName/%d", size), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				if _, err := client.EvmChainIdFromName(ctx, name); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,317 @@
+package remote
+
+import (
+	"context"
+	"io"
+	"log"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	chain_selectors "github.com/smartcontractkit/chain-selectors"
+)
+
+// Client fetches chain data from a remote all_selectors.yml file.
+// Each client owns its configuration, HTTP client and cache, so clients pointing at
+// different URLs or using different cache TTLs do not interfere with each other.
+// A Client is safe for concurrent use.
+type Client struct {
+	config     Config
+	httpClient *http.Client
+
+	// cache holds the parsed data served to lookups. The data is never modified once stored,
+	// so lookups read it without locking.
+	cache atomic.Pointer[remoteCacheData]
+	// fetchErr is the error of the last failed fetch since the last successful one
+	fetchErrLock sync.Mutex
+	fetchErr     error
+
+	// subscribers are notified of the changes between published and each newly fetched data
+	subscribersLock sync.Mutex
+	subscribers     map[int]func(Diff)
+	nextSubscriber  int
+	publishLock     sync.Mutex
+	published       *remoteCacheData
+
+	// refreshStop and refreshDone are set while the background refresh is running
+	refreshLock sync.Mutex
+	refreshStop context.CancelFunc
+	refreshDone chan struct{}
+}
+
+// NewClient returns a client configured with the given options.
+// Unset options fall back to DefaultGitHubRawURL, DefaultRemoteFetchTimeout and DefaultCacheTTL.
+func NewClient(opts ...Option) *Client {
+	config := applyOptions(opts)
+
+	httpClient := config.HTTPClient
+	if httpClient == nil {
+		httpClient = &http.Client{
+			Timeout: config.Timeout,
+		}
+	}
+
+	client := &Client{
+		config:     *config,
+		httpClient: httpClient,
+	}
+	if config.PersistPath != "" {
+		client.loadPersisted()
+	}
+	return client
+}
+
+// Config returns the configuration of the client.
+func (c *Client) Config() Config {
+	return c.config
+}
+
+// ClearCache clears the client's cache, forcing the next remote call to fetch fresh data
+func (c *Client) ClearCache() {
+	c.cache.Store(nil)
+}
+
+// CacheStatus describes the data cached by a client.
+type CacheStatus struct {
+	// FetchedAt is when the cached data was fetched, or zero if nothing is cached
+	FetchedAt time.Time
+	// Stale reports whether the cached data is older than the cache TTL
+	Stale bool
+	// LastError is the error of the last failed fetch since the last successful one
+	LastError error
+	// Quarantined holds the chains dropped from the cached data by MutationPolicyQuarantine
+	Quarantined []Mutation
+	// Invalid holds the chains dropped from the cached data because they failed chain_selectors.Validate
+	Invalid []chain_selectors.ValidationError
+}
+
+// CacheStatus returns the status of the client's cache.
+// With ServeStaleOnError set, a stale status with LastError set means calls are served expired data.
+func (c *Client) CacheStatus() CacheStatus {
+	c.fetchErrLock.Lock()
+	status := CacheStatus{LastError: c.fetchErr}
+	c.fetchErrLock.Unlock()
+
+	if cache := c.cache.Load(); cache != nil {
+		status.FetchedAt = cache.fetchedAt
+		status.Quarantined = cache.quarantined
+		status.Invalid = cache.invalid
+		status.Stale = time.Since(cache.fetchedAt) >= c.config.CacheTTL
+	}
+	return status
+}
+
+// fetchRemoteSelectors fetches and parses the remote selectors, using the client's cache when it is fresh
+func (c *Client) fetchRemoteSelectors(ctx context.Context) (*remoteCacheData, error) {
+	// Check cache first if TTL is set
+	if c.config.CacheTTL > 0 {
+		if cached := c.cache.Load(); cached != nil && time.Since(cached.fetchedAt) < c.config.CacheTTL {
+			return cached, nil
+		}
+	}
+
+	cache, err := c.fetch(ctx)
+	if err != nil && c.config.ServeStaleOnError {
+		if stale := c.cache.Load(); stale != nil {
+			log.Printf("WARN: Serving remote selectors fetched at %s because the refresh failed: %v", stale.fetchedAt.Format(time.RFC3339), err)
+			return stale, nil
+		}
+	}
+	return cache, err
+}
+
+// fetch downloads and parses the remote selectors, updates the cache and notifies subscribers.
+// The outcome is recorded for CacheStatus.
+func (c *Client) fetch(ctx context.Context) (*remoteCacheData, error) {
+	cache, err := c.download(ctx)
+
+	c.fetchErrLock.Lock()
+	c.fetchErr = err
+	c.fetchErrLock.Unlock()
+
+	return cache, err
+}
+
+// download fetches and parses the remote selectors
+func (c *Client) download(ctx context.Context) (*remoteCacheData, error) {
+	url := c.config.URL
+
+	// Create request with context
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, newLookupError(ErrRemoteFetch, err, "failed to create HTTP request: %v", err)
+	}
+
+	// Make the request conditional on the cached data having changed
+	cached := c.cache.Load()
+	if cached != nil {
+		if cached.etag != "" {
+			req.Header.Set("If-None-Match", cached.etag)
+		}
+		if cached.lastModified != "" {
+			req.Header.Set("If-Modified-Since", cached.lastModified)
+		}
+	}
+
+	// Execute request
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, newLookupError(ErrRemoteFetch, err, "failed to fetch remote selectors from %s: %v", url, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotModified && cached != nil {
+		c.publishLock.Lock()
+		defer c.publishLock.Unlock()
+		// A concurrent fetch may have replaced the data the request was conditional on
+		if current := c.cache.Load(); current != nil && current != cached {
+			return current, nil
+		}
+		// Reuse the parsed data and extend its freshness
+		refreshed := *cached
+		refreshed.fetchedAt = time.Now()
+		c.storeCache(&refreshed)
+		if c.config.PersistPath != "" {
+			c.touchPersisted(refreshed.fetchedAt)
+		}
+		return &refreshed, nil
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, newLookupError(ErrRemoteFetch, nil, "failed to fetch remote selectors, status code: %d", resp.StatusCode)
+	}
+
+	// Read response body
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, newLookupError(ErrRemoteFetch, err, "failed to read response body: %v", err)
+	}
+
+	// Verify the payload before parsing it
+	signature, err := c.fetchSignature(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.verify(url, body, signature); err != nil {
+		return nil, err
+	}
+
+	data, stellarPassphrases, invalid, err := decodeRemoteSelectors(body)
+	if err != nil {
+		return nil, err
+	}
+
+	// Guard against data that reroutes known chains. The lock is held until the data is published, so that
+	// concurrent fetches are checked against and publish their data one after the other.
+	c.publishLock.Lock()
+	defer c.publishLock.Unlock()
+	quarantined, err := c.checkMutations(&data, stellarPassphrases, c.published)
+	if err != nil {
+		return nil, err
+	}
+
+	cache := newRemoteCacheData(data, stellarPassphrases)
+	cache.quarantined = quarantined
+	cache.invalid = invalid
+	cache.etag = resp.Header.Get("ETag")
+	cache.lastModified = resp.Header.Get("Last-Modified")
+
+	c.storeCache(cache)
+	c.publish(cache)
+	if c.config.PersistPath != "" {
+		c.persist(body, signature)
+	}
+
+	return cache, nil
+}
+
+// storeCache updates the cache if TTL is set or stale data may be served
+func (c *Client) storeCache(cache *remoteCacheData) {
+	if c.config.CacheTTL > 0 || c.config.ServeStaleOnError {
+		c.cache.Store(cache)
+	}
+}
+
+// maxPackageClients bounds the number of package level clients kept for calls with options
+const maxPackageClients = 16
+
+// clientKey identifies the package level client used for a set of options
+type clientKey struct {
+	url               string
+	timeout           time.Duration
+	cacheTTL          time.Duration
+	serveStaleOnError bool
+	persistPath       string
+	sha256            string
+	publicKey         string
+	signatureURL      string
+	mutationPolicy    MutationPolicy
+}
+
+var (
+	// defaultClient backs the package level functions when no options are given
+	defaultClient = NewClient()
+
+	// clients holds the package level clients created for calls with options, keyed by their configuration
+	clients     = make(map[clientKey]*Client)
+	clientsLock sync.Mutex
+)
+
+// DefaultClient returns the client used by the package level functions when no options are given.
+func DefaultClient() *Client {
+	return defaultClient
+}
+
+// clientFor returns the package level client for the given options.
+// Calls with the same configuration share a client and therefore a cache. Calls with an HTTP client,
+// or with more distinct configurations than maxPackageClients, get a new client that is not kept,
+// so callers creating options per call don't accumulate clients.
+func clientFor(opts []Option) *Client {
+	if len(opts) == 0 {
+		return defaultClient
+	}
+
+	config := applyOptions(opts)
+	if config.HTTPClient != nil {
+		return NewClient(opts...)
+	}
+	key := clientKey{
+		url:               config.URL,
+		timeout:           config.Timeout,
+		cacheTTL:          config.CacheTTL,
+		serveStaleOnError: config.ServeStaleOnError,
+		persistPath:       config.PersistPath,
+		sha256:            config.SHA256,
+		publicKey:         string(config.PublicKey),
+		signatureURL:      config.SignatureURL,
+		mutationPolicy:    config.MutationPolicy,
+	}
+	if key == (clientKey{url: DefaultGitHubRawURL, timeout: DefaultRemoteFetchTimeout, cacheTTL: DefaultCacheTTL}) {
+		return defaultClient
+	}
+
+	clientsLock.Lock()
+	defer clientsLock.Unlock()
+
+	client, exists := clients[key]
+	if exists {
+		return client
+	}
+	client = NewClient(opts...)
+	if len(clients) < maxPackageClients {
+		clients[key] = client
+	}
+	return client
+}
+
+// ClearCache clears the cache of every package level client, forcing the next remote call to fetch fresh data
+func ClearCache() {
+	defaultClient.ClearCache()
+
+	clientsLock.Lock()
+	defer clientsLock.Unlock()
+	for _, client := range clients {
+		client.ClearCache()
+	}
+}
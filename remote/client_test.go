@@ -0,0 +1,224 @@
+package remote
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newCountingServer(t *testing.T, name string) (*httptest.Server, *atomic.Int32) {
+	var calls atomic.Int32
+	body := `
+evm:
+  4242424242:
+    selector: 8888888888888888888
+    name: ` + name + `
+    network_type: testnet
+`
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(body))
+	}))
+	t.Cleanup(server.Close)
+	return server, &calls
+}
+
+func TestClientCachesAreIsolated(t *testing.T) {
+	ctx := context.Background()
+	serverA, callsA := newCountingServer(t, "chain-from-mirror-a")
+	serverB, callsB := newCountingServer(t, "chain-from-mirror-b")
+
+	clientA := NewClient(WithURL(serverA.URL), WithCacheTTL(time.Minute))
+	clientB := NewClient(WithURL(serverB.URL), WithCacheTTL(time.Minute))
+
+	for i := 0; i < 2; i++ {
+		details, err := clientA.GetChainDetailsBySelector(ctx, 8888888888888888888)
+		require.NoError(t, err)
+		assert.Equal(t, "chain-from-mirror-a", details.ChainName)
+
+		details, err = clientB.GetChainDetailsBySelector(ctx, 8888888888888888888)
+		require.NoError(t, err)
+		assert.Equal(t, "chain-from-mirror-b", details.ChainName)
+	}
+	assert.Equal(t, int32(1), callsA.Load())
+	assert.Equal(t, int32(1), callsB.Load())
+
+	clientA.ClearCache()
+	_, err := clientA.GetChainDetailsBySelector(ctx, 8888888888888888888)
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), callsA.Load())
+	assert.Equal(t, int32(1), callsB.Load(), "clearing one client must not affect another")
+}
+
+func TestPackageFunctionsIsolateCachesPerURL(t *testing.T) {
+	ClearCache()
+	ctx := context.Background()
+	serverA, callsA := newCountingServer(t, "chain-from-mirror-a")
+	serverB, callsB := newCountingServer(t, "chain-from-mirror-b")
+
+	for i := 0; i < 2; i++ {
+		details, err := GetChainDetailsBySelector(ctx, 8888888888888888888, WithURL(serverA.URL))
+		require.NoError(t, err)
+		assert.Equal(t, "chain-from-mirror-a", details.ChainName)
+
+		// A short TTL caller must not evict the data cached for the long TTL caller
+		details, err = GetChainDetailsBySelector(ctx, 8888888888888888888, WithURL(serverB.URL), WithCacheTTL(0))
+		require.NoError(t, err)
+		assert.Equal(t, "chain-from-mirror-b", details.ChainName)
+	}
+	assert.Equal(t, int32(1), callsA.Load())
+	assert.Equal(t, int32(2), callsB.Load())
+
+	assert.Same(t, clientFor([]Option{WithURL(serverA.URL)}), clientFor([]Option{WithURL(serverA.URL)}))
+	assert.Same(t, DefaultClient(), clientFor(nil))
+	assert.Same(t, DefaultClient(), clientFor([]Option{WithURL(DefaultGitHubRawURL)}))
+}
+
+func TestPackageFunctionsDontKeepPerCallClients(t *testing.T) {
+	ctx := context.Background()
+	server, calls := newCountingServer(t, "chain-from-per-call-client")
+
+	clientsLock.Lock()
+	previous := clients
+	clients = make(map[clientKey]*Client)
+	clientsLock.Unlock()
+	t.Cleanup(func() {
+		clientsLock.Lock()
+		defer clientsLock.Unlock()
+		clients = previous
+	})
+
+	for i := 0; i < 3; i++ {
+		_, err := GetChainDetailsBySelector(ctx, 8888888888888888888, WithURL(server.URL), WithHTTPClient(&http.Client{}))
+		require.NoError(t, err)
+	}
+	assert.Equal(t, int32(3), calls.Load())
+	assert.Empty(t, clients)
+	assert.NotSame(t, clientFor([]Option{WithHTTPClient(http.DefaultClient)}), clientFor([]Option{WithHTTPClient(http.DefaultClient)}))
+
+	for i := 0; i < 2*maxPackageClients; i++ {
+		clientFor([]Option{WithURL(server.URL), WithTimeout(time.Duration(i+1) * time.Second)})
+	}
+	clientsLock.Lock()
+	defer clientsLock.Unlock()
+	assert.Len(t, clients, maxPackageClients)
+}
+
+func TestNewClient(t *testing.T) {
+	t.Run("defaults", func(t *testing.T) {
+		config := NewClient().Config()
+		assert.Equal(t, DefaultGitHubRawURL, config.URL)
+		assert.Equal(t, DefaultRemoteFetchTimeout, config.Timeout)
+		assert.Equal(t, DefaultCacheTTL, config.CacheTTL)
+	})
+
+	t.Run("custom http client", func(t *testing.T) {
+		server, calls := newCountingServer(t, "chain-from-custom-client")
+
+		var used atomic.Bool
+		httpClient := &http.Client{
+			Transport: roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+				used.Store(true)
+				return http.DefaultTransport.RoundTrip(req)
+			}),
+		}
+
+		client := NewClient(WithURL(server.URL), WithHTTPClient(httpClient))
+		details, err := client.GetChainDetailsBySelector(context.Background(), 8888888888888888888)
+		require.NoError(t, err)
+		assert.Equal(t, "chain-from-custom-client", details.ChainName)
+		assert.True(t, used.Load())
+		assert.Equal(t, int32(1), calls.Load())
+	})
+}
+
+const conditionalYAML = `
+evm:
+  4242424242:
+    selector: 8888888888888888888
+    name: test-conditional-chain
+`
+
+func TestClientConditionalRequests(t *testing.T) {
+	tests := []struct {
+		name        string
+		setHeaders  func(w http.ResponseWriter)
+		notModified func(r *http.Request) bool
+	}{
+		{
+			name: "ETag",
+			setHeaders: func(w http.ResponseWriter) {
+				w.Header().Set("ETag", `"v1"`)
+			},
+			notModified: func(r *http.Request) bool {
+				return r.Header.Get("If-None-Match") == `"v1"`
+			},
+		},
+		{
+			name: "Last-Modified",
+			setHeaders: func(w http.ResponseWriter) {
+				w.Header().Set("Last-Modified", "Wed, 21 Oct 2015 07:28:00 GMT")
+			},
+			notModified: func(r *http.Request) bool {
+				return r.Header.Get("If-Modified-Since") == "Wed, 21 Oct 2015 07:28:00 GMT"
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var fullResponses, notModifiedResponses atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				tt.setHeaders(w)
+				if tt.notModified(r) {
+					notModifiedResponses.Add(1)
+					w.WriteHeader(http.StatusNotModified)
+					return
+				}
+				fullResponses.Add(1)
+				w.WriteHeader(http.StatusOK)
+				w.Write([]byte(conditionalYAML))
+			}))
+			t.Cleanup(server.Close)
+
+			ctx := context.Background()
+			client := NewClient(WithURL(server.URL), WithCacheTTL(time.Minute))
+
+			first, err := client.fetch(ctx)
+			require.NoError(t, err)
+
+			second, err := client.fetch(ctx)
+			require.NoError(t, err)
+			assert.Equal(t, int32(1), fullResponses.Load())
+			assert.Equal(t, int32(1), notModifiedResponses.Load())
+
+			// The parsed data is reused and its freshness extended
+			assert.Equal(t, first.evmChainsBySelector, second.evmChainsBySelector)
+			assert.True(t, second.fetchedAt.After(first.fetchedAt))
+
+			details, err := client.GetChainDetailsBySelector(ctx, 8888888888888888888)
+			require.NoError(t, err)
+			assert.Equal(t, "test-conditional-chain", details.ChainName)
+			assert.Equal(t, int32(2), fullResponses.Load()+notModifiedResponses.Load(), "cache should still be fresh")
+
+			// Clearing the cache drops the validators, so the next request is unconditional
+			client.ClearCache()
+			_, err = client.fetch(ctx)
+			require.NoError(t, err)
+			assert.Equal(t, int32(2), fullResponses.Load())
+		})
+	}
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
@@ -0,0 +1,96 @@
+package remote
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Run with -race to detect unsynchronized access to the client's cache.
+func TestClientLookupsDuringRefresh(t *testing.T) {
+	ctx := context.Background()
+	server, _ := newCountingServer(t, "test-concurrent-chain")
+	client := NewClient(
+		WithURL(server.URL),
+		WithCacheTTL(time.Millisecond),
+		WithRefreshInterval(time.Millisecond),
+		WithServeStaleOnError(),
+	)
+	require.NoError(t, client.Start(ctx))
+	t.Cleanup(client.Close)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 50; j++ {
+				details, err := client.GetChainDetailsBySelector(ctx, 8888888888888888888)
+				if assert.NoError(t, err) {
+					assert.Equal(t, "test-concurrent-chain", details.ChainName)
+				}
+				client.CacheStatus()
+			}
+		}()
+	}
+
+	for i := 0; i < 50; i++ {
+		client.ClearCache()
+	}
+	wg.Wait()
+}
+
+func TestConcurrentFetchesAreGuardedInTurn(t *testing.T) {
+	var (
+		requests atomic.Int32
+		arrived  sync.WaitGroup
+	)
+	arrived.Add(2)
+	// Both requests are answered once both are in flight, each with different selectors for the same chains.
+	// The payloads are large enough for both fetches to be checked at the same time if they weren't taking turns.
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := requests.Add(1)
+		arrived.Done()
+		arrived.Wait()
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintln(w, "evm:")
+		for i := uint64(0); i < 2000; i++ {
+			fmt.Fprintf(w, "  %d:\n    selector: %d\n", 4242420000+i, 7777777777777700000+uint64(n)*10000+i)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	ctx := context.Background()
+	client := NewClient(WithURL(server.URL))
+	var diffs atomic.Int32
+	client.Subscribe(func(Diff) { diffs.Add(1) })
+
+	errs := make(chan error, 2)
+	for i := 0; i < 2; i++ {
+		go func() {
+			_, err := client.fetch(ctx)
+			errs <- err
+		}()
+	}
+
+	var mutationErrs int
+	for i := 0; i < 2; i++ {
+		var mutationErr *MutationError
+		if err := <-errs; errors.As(err, &mutationErr) {
+			mutationErrs++
+		} else {
+			require.NoError(t, err)
+		}
+	}
+	assert.Equal(t, 1, mutationErrs, "the second fetch must be checked against the data published by the first")
+	assert.Equal(t, int32(1), diffs.Load())
+}
@@ -0,0 +1,35 @@
+package remote
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrRemoteFetch is returned when the remote selectors can't be fetched or parsed.
+// Errors from the chain_selectors package, e.g. chain_selectors.ErrUnknownSelector,
+// are used for failed lookups in the fetched data.
+var ErrRemoteFetch = errors.New("remote fetch failed")
+
+// lookupError keeps the message remote calls have always returned while exposing
+// the sentinel or typed errors describing the failure to errors.Is and errors.As.
+type lookupError struct {
+	message string
+	errs    []error
+}
+
+func (e *lookupError) Error() string {
+	return e.message
+}
+
+func (e *lookupError) Unwrap() []error {
+	return e.errs
+}
+
+// newLookupError returns an error with the formatted message wrapping err and, if not nil, cause.
+func newLookupError(err error, cause error, format string, args ...any) error {
+	errs := []error{err}
+	if cause != nil {
+		errs = append(errs, cause)
+	}
+	return &lookupError{message: fmt.Sprintf(format, args...), errs: errs}
+}
@@ -0,0 +1,77 @@
+package remote
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	chain_selectors "github.com/smartcontractkit/chain-selectors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClientLookupErrors(t *testing.T) {
+	ctx := context.Background()
+	server, _ := newCountingServer(t, "test-errors-chain")
+	client := NewClient(WithURL(server.URL))
+
+	_, err := client.GetChainDetailsBySelector(ctx, 1)
+	assert.ErrorIs(t, err, chain_selectors.ErrUnknownSelector)
+	assert.Equal(t, "unknown chain selector 1", err.Error())
+
+	_, err = client.IsEvm(ctx, 1)
+	assert.ErrorIs(t, err, chain_selectors.ErrUnknownSelector)
+
+	_, err = client.EvmChainIdFromName(ctx, "not-a-chain")
+	assert.ErrorIs(t, err, chain_selectors.ErrUnknownChainName)
+
+	_, err = client.GetChainDetailsByChainIDAndFamily(ctx, "5151515151", chain_selectors.FamilyEVM)
+	var unknown chain_selectors.ErrUnknownChainID
+	require.ErrorAs(t, err, &unknown)
+	assert.Equal(t, chain_selectors.ErrUnknownChainID{Family: chain_selectors.FamilyEVM, ChainID: "5151515151"}, unknown)
+
+	_, err = client.StellarPassphraseFromChainId(ctx, "not-a-network")
+	require.ErrorAs(t, err, &unknown)
+	assert.Equal(t, chain_selectors.FamilyStellar, unknown.Family)
+
+	_, err = client.GetChainDetailsByChainIDAndFamily(ctx, "not-a-number", chain_selectors.FamilyTon)
+	assert.ErrorIs(t, err, chain_selectors.ErrInvalidChainID)
+	var numErr *strconv.NumError
+	assert.ErrorAs(t, err, &numErr)
+
+	_, err = client.GetChainDetailsByChainIDAndFamily(ctx, "1", "not-a-family")
+	assert.ErrorIs(t, err, chain_selectors.ErrUnsupportedFamily)
+}
+
+func TestClientFetchErrors(t *testing.T) {
+	ctx := context.Background()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	t.Cleanup(server.Close)
+
+	_, err := NewClient(WithURL(server.URL)).GetChainDetailsBySelector(ctx, 1)
+	assert.ErrorIs(t, err, ErrRemoteFetch)
+	assert.NotErrorIs(t, err, chain_selectors.ErrUnknownSelector)
+	assert.Equal(t, "failed to fetch remote selectors, status code: 500", err.Error())
+
+	invalid := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("evm: [not, a, map"))
+	}))
+	t.Cleanup(invalid.Close)
+
+	_, err = NewClient(WithURL(invalid.URL)).GetChainDetailsBySelector(ctx, 1)
+	assert.ErrorIs(t, err, ErrRemoteFetch)
+
+	failing := NewClient(WithURL(server.URL), WithHTTPClient(&http.Client{
+		Transport: roundTripperFunc(func(*http.Request) (*http.Response, error) {
+			return nil, context.DeadlineExceeded
+		}),
+	}))
+	_, err = failing.GetChainDetailsBySelector(ctx, 1)
+	assert.ErrorIs(t, err, ErrRemoteFetch)
+	assert.ErrorIs(t, err, context.DeadlineExceeded, "the transport error is kept as cause")
+}
@@ -2,16 +2,14 @@ package remote
 
 import (
 	"context"
-	"fmt"
 	"strconv"
 
 	chain_selectors "github.com/smartcontractkit/chain-selectors"
 )
 
 // EvmChainIdToChainSelector fetches chain data from GitHub and returns a map of EVM chain ID to chain selector
-func EvmChainIdToChainSelector(ctx context.Context, opts ...Option) (map[uint64]uint64, error) {
-	config := applyOptions(opts)
-	cache, err := fetchRemoteSelectors(ctx, config)
+func (c *Client) EvmChainIdToChainSelector(ctx context.Context) (map[uint64]uint64, error) {
+	cache, err := c.fetchRemoteSelectors(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -23,26 +21,27 @@ func EvmChainIdToChainSelector(ctx context.Context, opts ...Option) (map[uint64]
 	return result, nil
 }
 
+// EvmChainIdToChainSelector calls Client.EvmChainIdToChainSelector on the package level client for the given options.
+func EvmChainIdToChainSelector(ctx context.Context, opts ...Option) (map[uint64]uint64, error) {
+	return clientFor(opts).EvmChainIdToChainSelector(ctx)
+}
+
 // EvmChainIdFromName returns the EVM chain ID for a given chain name.
 // It first checks local embedded data, then falls back to remote if not found.
-func EvmChainIdFromName(ctx context.Context, name string, opts ...Option) (uint64, error) {
-	config := applyOptions(opts)
-	
+func (c *Client) EvmChainIdFromName(ctx context.Context, name string) (uint64, error) {
 	// Try local data first
-	if chainId, err := chain_selectors.ChainIdFromName(name); err == nil {
-		return chainId, nil
+	if ch, exists := chain_selectors.ChainByName(name); exists {
+		return ch.EvmChainID, nil
 	}
 	// If not found locally, try remote
 	
-	cache, err := fetchRemoteSelectors(ctx, config)
+	cache, err := c.fetchRemoteSelectors(ctx)
 	if err != nil {
 		return 0, err
 	}
 
-	for chainId, details := range cache.evmChainIdToChainSelector {
-		if details.ChainName == name {
-			return chainId, nil
-		}
+	if chainId, exists := cache.evmChainIDsByName[name]; exists {
+		return chainId, nil
 	}
 
 	// Before returning error, check if name is actually a chain ID (for chains without a name)
@@ -53,21 +52,24 @@ func EvmChainIdFromName(ctx context.Context, name string, opts ...Option) (uint6
 		}
 	}
 
-	return 0, fmt.Errorf("chain not found for name %s", name)
+	return 0, newLookupError(chain_selectors.ErrUnknownChainName, nil, "chain not found for name %s", name)
+}
+
+// EvmChainIdFromName calls Client.EvmChainIdFromName on the package level client for the given options.
+func EvmChainIdFromName(ctx context.Context, name string, opts ...Option) (uint64, error) {
+	return clientFor(opts).EvmChainIdFromName(ctx, name)
 }
 
 // EvmChainBySelector returns the EVM chain for a given selector.
 // It first checks local embedded data, then falls back to remote if not found.
-func EvmChainBySelector(ctx context.Context, sel uint64, opts ...Option) (chain_selectors.Chain, bool, error) {
-	config := applyOptions(opts)
-	
+func (c *Client) EvmChainBySelector(ctx context.Context, sel uint64) (chain_selectors.Chain, bool, error) {
 	// Try local data first
 	if ch, exists := chain_selectors.ChainBySelector(sel); exists {
 		return ch, true, nil
 	}
 	// If not found locally, try remote
 	
-	cache, err := fetchRemoteSelectors(ctx, config)
+	cache, err := c.fetchRemoteSelectors(ctx)
 	if err != nil {
 		return chain_selectors.Chain{}, false, err
 	}
@@ -76,18 +78,21 @@ func EvmChainBySelector(ctx context.Context, sel uint64, opts ...Option) (chain_
 	return ch, exists, nil
 }
 
+// EvmChainBySelector calls Client.EvmChainBySelector on the package level client for the given options.
+func EvmChainBySelector(ctx context.Context, sel uint64, opts ...Option) (chain_selectors.Chain, bool, error) {
+	return clientFor(opts).EvmChainBySelector(ctx, sel)
+}
+
 // EvmChainByEvmChainID returns the EVM chain for a given EVM chain ID.
 // It first checks local embedded data, then falls back to remote if not found.
-func EvmChainByEvmChainID(ctx context.Context, evmChainID uint64, opts ...Option) (chain_selectors.Chain, bool, error) {
-	config := applyOptions(opts)
-	
+func (c *Client) EvmChainByEvmChainID(ctx context.Context, evmChainID uint64) (chain_selectors.Chain, bool, error) {
 	// Try local data first
 	if ch, exists := chain_selectors.ChainByEvmChainID(evmChainID); exists {
 		return ch, true, nil
 	}
 	// If not found locally, try remote
 	
-	cache, err := fetchRemoteSelectors(ctx, config)
+	cache, err := c.fetchRemoteSelectors(ctx)
 	if err != nil {
 		return chain_selectors.Chain{}, false, err
 	}
@@ -96,11 +101,14 @@ func EvmChainByEvmChainID(ctx context.Context, evmChainID uint64, opts ...Option
 	return ch, exists, nil
 }
 
+// EvmChainByEvmChainID calls Client.EvmChainByEvmChainID on the package level client for the given options.
+func EvmChainByEvmChainID(ctx context.Context, evmChainID uint64, opts ...Option) (chain_selectors.Chain, bool, error) {
+	return clientFor(opts).EvmChainByEvmChainID(ctx, evmChainID)
+}
+
 // IsEvm checks if a chain selector is for an EVM chain.
 // It first checks local embedded data, then falls back to remote if not found.
-func IsEvm(ctx context.Context, chainSel uint64, opts ...Option) (bool, error) {
-	config := applyOptions(opts)
-	
+func (c *Client) IsEvm(ctx context.Context, chainSel uint64) (bool, error) {
 	// Try local data first
 	isEvm, err := chain_selectors.IsEvm(chainSel)
 	if err == nil {
@@ -108,14 +116,19 @@ func IsEvm(ctx context.Context, chainSel uint64, opts ...Option) (bool, error) {
 	}
 	// If not found locally, try remote
 	
-	cache, err := fetchRemoteSelectors(ctx, config)
+	cache, err := c.fetchRemoteSelectors(ctx)
 	if err != nil {
 		return false, err
 	}
 
 	_, exists := cache.evmChainsBySelector[chainSel]
 	if !exists {
-		return false, fmt.Errorf("chain %d not found", chainSel)
+		return false, newLookupError(chain_selectors.ErrUnknownSelector, nil, "chain %d not found", chainSel)
 	}
 	return true, nil
 }
+
+// IsEvm calls Client.IsEvm on the package level client for the given options.
+func IsEvm(ctx context.Context, chainSel uint64, opts ...Option) (bool, error) {
+	return clientFor(opts).IsEvm(ctx, chainSel)
+}
@@ -0,0 +1,145 @@
+package remote
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	chain_selectors "github.com/smartcontractkit/chain-selectors"
+)
+
+// MutationPolicy decides what happens to fetched data that changes the selector or family of a known chain.
+type MutationPolicy int
+
+const (
+	// MutationPolicyReject rejects the whole payload with a MutationError
+	MutationPolicyReject MutationPolicy = iota
+	// MutationPolicyQuarantine drops the mutating entries and keeps the rest of the payload.
+	// The dropped entries are reported by Client.CacheStatus.
+	MutationPolicyQuarantine
+)
+
+// Mutation is a fetched chain that conflicts with a chain known from the embedded data or previously fetched data.
+type Mutation struct {
+	// Fetched is the chain as found in the fetched data
+	Fetched ChainDetailsWithMetadata
+	// Known is the conflicting chain
+	Known ChainDetailsWithMetadata
+	// Source is where Known comes from, "embedded" or "cached"
+	Source string
+}
+
+func (m Mutation) String() string {
+	if m.Fetched.Family == m.Known.Family && m.Fetched.ChainID == m.Known.ChainID {
+		return fmt.Sprintf("%s chain %s changed selector from %d (%s) to %d",
+			m.Fetched.Family, m.Fetched.ChainID, m.Known.ChainSelector, m.Source, m.Fetched.ChainSelector)
+	}
+	return fmt.Sprintf("selector %d moved from %s chain %s (%s) to %s chain %s",
+		m.Fetched.ChainSelector, m.Known.Family, m.Known.ChainID, m.Source, m.Fetched.Family, m.Fetched.ChainID)
+}
+
+// MutationError is returned when fetched data changes the selector or family of known chains
+// and the client uses MutationPolicyReject. The payload is never parsed into the cache.
+type MutationError struct {
+	Mutations []Mutation
+}
+
+func (e *MutationError) Error() string {
+	descriptions := make([]string, 0, len(e.Mutations))
+	for _, m := range e.Mutations {
+		descriptions = append(descriptions, m.String())
+	}
+	return fmt.Sprintf("remote selectors mutate known chains: %s", strings.Join(descriptions, "; "))
+}
+
+// embeddedRegistry holds the selectors embedded in the chain_selectors package that remote data is checked against
+var embeddedRegistry = chain_selectors.NewEmbeddedRegistry()
+
+// checkMutations compares the fetched chains in data against the embedded chains and previous.
+// With MutationPolicyQuarantine the mutating chains are removed from data and stellarPassphrases and returned,
+// otherwise a MutationError is returned. Chains of previous that conflict with quarantined chains are carried
+// forward, so that the next fetch is checked against them too and keeps quarantining the same entries.
+func (c *Client) checkMutations(data *chain_selectors.ExtraSelectorsData, stellarPassphrases map[string]string, previous *remoteCacheData) ([]Mutation, error) {
+	previousChains := previous.chains()
+	previousBySelector := make(map[uint64]ChainDetailsWithMetadata, len(previousChains))
+	for _, chain := range previousChains {
+		previousBySelector[chain.ChainSelector] = chain
+	}
+
+	var mutations []Mutation
+	forEachChain(*data, func(fetched ChainDetailsWithMetadata) {
+		if known, exists := embeddedChainByChainID(fetched.Family, fetched.ChainID); exists && known.ChainSelector != fetched.ChainSelector {
+			mutations = append(mutations, Mutation{Fetched: fetched, Known: known, Source: "embedded"})
+			return
+		}
+		if known, exists := embeddedChainBySelector(fetched.ChainSelector); exists && (known.Family != fetched.Family || known.ChainID != fetched.ChainID) {
+			mutations = append(mutations, Mutation{Fetched: fetched, Known: known, Source: "embedded"})
+			return
+		}
+		if known, exists := previousChains[chainKey{family: fetched.Family, chainID: fetched.ChainID}]; exists && known.ChainSelector != fetched.ChainSelector {
+			mutations = append(mutations, Mutation{Fetched: fetched, Known: known, Source: "cached"})
+			return
+		}
+		if known, exists := previousBySelector[fetched.ChainSelector]; exists && (known.Family != fetched.Family || known.ChainID != fetched.ChainID) {
+			mutations = append(mutations, Mutation{Fetched: fetched, Known: known, Source: "cached"})
+		}
+	})
+	if len(mutations) == 0 {
+		return nil, nil
+	}
+
+	sort.Slice(mutations, func(i, j int) bool {
+		if mutations[i].Fetched.Family != mutations[j].Fetched.Family {
+			return mutations[i].Fetched.Family < mutations[j].Fetched.Family
+		}
+		return mutations[i].Fetched.ChainID < mutations[j].Fetched.ChainID
+	})
+
+	if c.config.MutationPolicy != MutationPolicyQuarantine {
+		return nil, &MutationError{Mutations: mutations}
+	}
+
+	for _, m := range mutations {
+		log.Printf("WARN: Quarantining remote selector: %s", m)
+		data.Remove(m.Fetched.Family, m.Fetched.ChainID)
+		if m.Fetched.Family == chain_selectors.FamilyStellar {
+			delete(stellarPassphrases, m.Fetched.ChainID)
+		}
+	}
+	// Embedded chains are always served locally, previously fetched ones must stay in the data
+	for _, m := range mutations {
+		if m.Source != "cached" {
+			continue
+		}
+		data.Set(m.Known.Family, m.Known.ChainID, m.Known.ChainDetails)
+		if m.Known.Family == chain_selectors.FamilyStellar {
+			stellarPassphrases[m.Known.ChainID] = previous.stellarChainIdToPassphrase[m.Known.ChainID]
+		}
+	}
+	return mutations, nil
+}
+
+func embeddedChainByChainID(family, chainID string) (ChainDetailsWithMetadata, bool) {
+	details, err := embeddedRegistry.GetChainDetailsByChainIDAndFamily(chainID, family)
+	if err != nil {
+		return ChainDetailsWithMetadata{}, false
+	}
+	return ChainDetailsWithMetadata{ChainDetails: details, Family: family, ChainID: chainID}, true
+}
+
+func embeddedChainBySelector(selector uint64) (ChainDetailsWithMetadata, bool) {
+	family, err := embeddedRegistry.GetSelectorFamily(selector)
+	if err != nil {
+		return ChainDetailsWithMetadata{}, false
+	}
+	chainID, err := embeddedRegistry.GetChainIDFromSelector(selector)
+	if err != nil {
+		return ChainDetailsWithMetadata{}, false
+	}
+	details, err := embeddedRegistry.GetChainDetails(selector)
+	if err != nil {
+		return ChainDetailsWithMetadata{}, false
+	}
+	return ChainDetailsWithMetadata{ChainDetails: details, Family: family, ChainID: chainID}, true
+}
@@ -0,0 +1,224 @@
+package remote
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	chain_selectors "github.com/smartcontractkit/chain-selectors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMutationGuard(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name    string
+		payload string
+		want    string
+	}{
+		{
+			name: "known chain id with a different selector",
+			payload: `
+evm:
+  1:
+    selector: 8888888888888888888
+    name: ethereum-mainnet
+`,
+			want: "evm chain 1 changed selector from 5009297550715157269 (embedded) to 8888888888888888888",
+		},
+		{
+			name: "known selector moved to another family",
+			payload: `
+solana:
+  ASwXBTzJM5evpfrWSHSjZaxPErZRuiGJnFixGUHi4NQT:
+    selector: 5009297550715157269
+    name: rerouted-chain
+`,
+			want: "selector 5009297550715157269 moved from evm chain 1 (embedded) to solana chain ASwXBTzJM5evpfrWSHSjZaxPErZRuiGJnFixGUHi4NQT",
+		},
+		{
+			name: "known selector moved to another chain id",
+			payload: `
+canton:
+  RemoteNet:
+    selector: 2308837218439511688
+    name: canton-mainnet
+`,
+			want: "selector 2308837218439511688 moved from canton chain MainNet (embedded) to canton chain RemoteNet",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusOK)
+				w.Write([]byte(tt.payload + `
+cosmos:
+  remote-1:
+    selector: 7777777777777777777
+    name: test-remote-only-chain
+`))
+			}))
+			t.Cleanup(server.Close)
+
+			t.Run("reject", func(t *testing.T) {
+				client := NewClient(WithURL(server.URL))
+				_, err := client.GetChainDetailsByChainIDAndFamily(ctx, "remote-1", chain_selectors.FamilyCosmos)
+
+				var mutationErr *MutationError
+				require.True(t, errors.As(err, &mutationErr), "expected a MutationError, got %v", err)
+				require.Len(t, mutationErr.Mutations, 1)
+				assert.Equal(t, tt.want, mutationErr.Mutations[0].String())
+				assert.Equal(t, "embedded", mutationErr.Mutations[0].Source)
+			})
+
+			t.Run("quarantine", func(t *testing.T) {
+				client := NewClient(WithURL(server.URL), WithMutationPolicy(MutationPolicyQuarantine))
+				details, err := client.GetChainDetailsByChainIDAndFamily(ctx, "remote-1", chain_selectors.FamilyCosmos)
+				require.NoError(t, err)
+				assert.Equal(t, uint64(7777777777777777777), details.ChainSelector)
+
+				quarantined := client.CacheStatus().Quarantined
+				require.Len(t, quarantined, 1)
+				assert.Equal(t, tt.want, quarantined[0].String())
+			})
+		})
+	}
+}
+
+func TestMutationGuardAgainstCachedData(t *testing.T) {
+	var (
+		mu   sync.Mutex
+		body = `
+evm:
+  4242424242:
+    selector: 7777777777777777777
+    name: test-remote-only-chain
+`
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(body))
+	}))
+	t.Cleanup(server.Close)
+
+	ctx := context.Background()
+	client := NewClient(WithURL(server.URL))
+	_, err := client.fetch(ctx)
+	require.NoError(t, err)
+
+	mu.Lock()
+	body = `
+evm:
+  4242424242:
+    selector: 6666666666666666666
+    name: test-remote-only-chain
+`
+	mu.Unlock()
+
+	_, err = client.fetch(ctx)
+	var mutationErr *MutationError
+	require.True(t, errors.As(err, &mutationErr), "expected a MutationError, got %v", err)
+	assert.Equal(t, "cached", mutationErr.Mutations[0].Source)
+	assert.EqualError(t, err, "remote selectors mutate known chains: evm chain 4242424242 changed selector from 7777777777777777777 (cached) to 6666666666666666666")
+
+	// The previously fetched data is still served
+	details, err := client.GetChainDetailsByChainIDAndFamily(ctx, "4242424242", chain_selectors.FamilyEVM)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(7777777777777777777), details.ChainSelector)
+}
+
+func TestQuarantineAgainstCachedDataLasts(t *testing.T) {
+	var (
+		mu   sync.Mutex
+		body = `
+evm:
+  4242424242:
+    selector: 7777777777777777777
+    name: test-remote-only-chain
+`
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(body))
+	}))
+	t.Cleanup(server.Close)
+
+	ctx := context.Background()
+	client := NewClient(WithURL(server.URL), WithMutationPolicy(MutationPolicyQuarantine))
+	_, err := client.fetch(ctx)
+	require.NoError(t, err)
+
+	mu.Lock()
+	body = `
+evm:
+  4242424242:
+    selector: 6666666666666666666
+    name: test-remote-only-chain
+`
+	mu.Unlock()
+
+	// The mutated chain is quarantined on every fetch, not only the first one after the original data
+	for i := 0; i < 2; i++ {
+		_, err = client.fetch(ctx)
+		require.NoError(t, err)
+
+		quarantined := client.CacheStatus().Quarantined
+		require.Len(t, quarantined, 1)
+		assert.Equal(t, "evm chain 4242424242 changed selector from 7777777777777777777 (cached) to 6666666666666666666", quarantined[0].String())
+
+		details, err := client.GetChainDetailsByChainIDAndFamily(ctx, "4242424242", chain_selectors.FamilyEVM)
+		require.NoError(t, err)
+		assert.Equal(t, uint64(7777777777777777777), details.ChainSelector)
+		_, err = client.GetChainDetailsBySelector(ctx, 6666666666666666666)
+		assert.ErrorIs(t, err, chain_selectors.ErrUnknownSelector)
+	}
+}
+
+func TestInvalidRemoteSelectorsAreDropped(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`
+evm:
+  0:
+    selector: 7777777777777777777
+  4242424242:
+    selector: 6666666666666666666
+    name: test-remote-only-chain
+stellar:
+  not-a-hash:
+    selector: 5555555555555555555
+    passphrase: Test Network
+`))
+	}))
+	t.Cleanup(server.Close)
+
+	ctx := context.Background()
+	client := NewClient(WithURL(server.URL))
+
+	// The valid chains are still served
+	details, err := client.GetChainDetailsBySelector(ctx, 6666666666666666666)
+	require.NoError(t, err)
+	assert.Equal(t, "test-remote-only-chain", details.ChainName)
+
+	_, err = client.GetChainDetailsBySelector(ctx, 7777777777777777777)
+	assert.ErrorIs(t, err, chain_selectors.ErrUnknownSelector)
+	_, err = client.StellarPassphraseFromChainId(ctx, "not-a-hash")
+	assert.Error(t, err)
+
+	invalid := client.CacheStatus().Invalid
+	require.Len(t, invalid, 3)
+	assert.EqualError(t, invalid[0], "evm chain 0: invalid evm chain ID: must be > 0")
+	assert.ErrorIs(t, invalid[0], chain_selectors.ErrInvalidChainID)
+	assert.Equal(t, chain_selectors.FamilyStellar, invalid[1].Family)
+	assert.ErrorContains(t, invalid[2], `must be the SHA-256 hash of passphrase "Test Network"`)
+}
@@ -0,0 +1,124 @@
+package remote
+
+import (
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+var (
+	// ErrChecksumMismatch is returned, wrapped in an IntegrityError, when the payload does not match the pinned SHA-256 digest
+	ErrChecksumMismatch = errors.New("checksum mismatch")
+	// ErrInvalidSignature is returned, wrapped in an IntegrityError, when the payload signature is malformed or does not verify
+	ErrInvalidSignature = errors.New("invalid signature")
+)
+
+// IntegrityError is returned when a payload fails the integrity checks configured
+// with WithSHA256 or WithEd25519PublicKey. The payload is never parsed into the cache.
+type IntegrityError struct {
+	// Source is the URL or file the payload was read from
+	Source string
+	// Err is ErrChecksumMismatch or ErrInvalidSignature, possibly wrapped with details
+	Err error
+}
+
+func (e *IntegrityError) Error() string {
+	return fmt.Sprintf("integrity verification of %s failed: %v", e.Source, e.Err)
+}
+
+func (e *IntegrityError) Unwrap() error {
+	return e.Err
+}
+
+// signatureURL returns the URL of the detached signature of the payload
+func (c *Client) signatureURL() string {
+	if c.config.SignatureURL != "" {
+		return c.config.SignatureURL
+	}
+	return c.config.URL + ".sig"
+}
+
+// fetchSignature downloads the detached signature of the payload, if a public key is configured
+func (c *Client) fetchSignature(ctx context.Context) ([]byte, error) {
+	if len(c.config.PublicKey) == 0 {
+		return nil, nil
+	}
+
+	url := c.signatureURL()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, newLookupError(ErrRemoteFetch, err, "failed to create HTTP request: %v", err)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, newLookupError(ErrRemoteFetch, err, "failed to fetch remote selectors signature from %s: %v", url, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, newLookupError(ErrRemoteFetch, nil, "failed to fetch remote selectors signature, status code: %d", resp.StatusCode)
+	}
+
+	signature, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, newLookupError(ErrRemoteFetch, err, "failed to read signature response body: %v", err)
+	}
+	return signature, nil
+}
+
+// verify checks body against the pinned digest and the detached signature, if configured
+func (c *Client) verify(source string, body, signature []byte) error {
+	if c.config.SHA256 != "" {
+		digest := sha256.Sum256(body)
+		actual := hex.EncodeToString(digest[:])
+		if !strings.EqualFold(actual, c.config.SHA256) {
+			return &IntegrityError{
+				Source: source,
+				Err:    fmt.Errorf("%w: expected sha256 %s, got %s", ErrChecksumMismatch, c.config.SHA256, actual),
+			}
+		}
+	}
+
+	if len(c.config.PublicKey) > 0 {
+		if len(c.config.PublicKey) != ed25519.PublicKeySize {
+			return &IntegrityError{
+				Source: source,
+				Err:    fmt.Errorf("%w: expected a %d byte ed25519 public key, got %d bytes", ErrInvalidSignature, ed25519.PublicKeySize, len(c.config.PublicKey)),
+			}
+		}
+		sig, err := decodeSignature(signature)
+		if err != nil {
+			return &IntegrityError{Source: source, Err: fmt.Errorf("%w: %v", ErrInvalidSignature, err)}
+		}
+		if !ed25519.Verify(c.config.PublicKey, body, sig) {
+			return &IntegrityError{Source: source, Err: ErrInvalidSignature}
+		}
+	}
+
+	return nil
+}
+
+// decodeSignature accepts a raw ed25519 signature or its base64 or hex encoding
+func decodeSignature(signature []byte) ([]byte, error) {
+	if len(signature) == ed25519.SignatureSize {
+		return signature, nil
+	}
+
+	encoded := string(bytes.TrimSpace(signature))
+	if sig, err := base64.StdEncoding.DecodeString(encoded); err == nil && len(sig) == ed25519.SignatureSize {
+		return sig, nil
+	}
+	if sig, err := hex.DecodeString(encoded); err == nil && len(sig) == ed25519.SignatureSize {
+		return sig, nil
+	}
+	return nil, fmt.Errorf("expected a %d byte ed25519 signature, raw or base64 or hex encoded", ed25519.SignatureSize)
+}
@@ -0,0 +1,138 @@
+package remote
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const integrityYAML = `
+evm:
+  4242424242:
+    selector: 8888888888888888888
+    name: test-signed-chain
+`
+
+// newSignedServer serves payload at / and signature at /all_selectors.yml.sig
+func newSignedServer(t *testing.T, payload string, signature []byte) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/all_selectors.yml":
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(payload))
+		case "/all_selectors.yml.sig":
+			w.WriteHeader(http.StatusOK)
+			w.Write(signature)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestSHA256Verification(t *testing.T) {
+	ctx := context.Background()
+	server := newSignedServer(t, integrityYAML, nil)
+	url := server.URL + "/all_selectors.yml"
+
+	digest := sha256.Sum256([]byte(integrityYAML))
+	pinned := hex.EncodeToString(digest[:])
+
+	details, err := NewClient(WithURL(url), WithSHA256(pinned)).GetChainDetailsBySelector(ctx, 8888888888888888888)
+	require.NoError(t, err)
+	assert.Equal(t, "test-signed-chain", details.ChainName)
+
+	tampered := sha256.Sum256([]byte("something else"))
+	client := NewClient(WithURL(url), WithSHA256(hex.EncodeToString(tampered[:])))
+	_, err = client.GetChainDetailsBySelector(ctx, 8888888888888888888)
+
+	var integrityErr *IntegrityError
+	require.True(t, errors.As(err, &integrityErr))
+	assert.Equal(t, url, integrityErr.Source)
+	assert.ErrorIs(t, err, ErrChecksumMismatch)
+	assert.Nil(t, client.cache.Load(), "rejected payload must not be cached")
+}
+
+func TestEd25519Verification(t *testing.T) {
+	ctx := context.Background()
+	publicKey, privateKey, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+	signature := ed25519.Sign(privateKey, []byte(integrityYAML))
+
+	tests := []struct {
+		name      string
+		payload   string
+		signature []byte
+		wantErr   error
+	}{
+		{name: "raw signature", payload: integrityYAML, signature: signature},
+		{name: "base64 signature", payload: integrityYAML, signature: []byte(base64.StdEncoding.EncodeToString(signature) + "\n")},
+		{name: "hex signature", payload: integrityYAML, signature: []byte(hex.EncodeToString(signature))},
+		{name: "tampered payload", payload: integrityYAML + "  # tampered\n", signature: signature, wantErr: ErrInvalidSignature},
+		{name: "malformed signature", payload: integrityYAML, signature: []byte("not a signature"), wantErr: ErrInvalidSignature},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := newSignedServer(t, tt.payload, tt.signature)
+			client := NewClient(WithURL(server.URL+"/all_selectors.yml"), WithEd25519PublicKey(publicKey))
+
+			details, err := client.GetChainDetailsBySelector(ctx, 8888888888888888888)
+			if tt.wantErr != nil {
+				var integrityErr *IntegrityError
+				assert.True(t, errors.As(err, &integrityErr))
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "test-signed-chain", details.ChainName)
+		})
+	}
+
+	t.Run("malformed public key", func(t *testing.T) {
+		server := newSignedServer(t, integrityYAML, signature)
+		client := NewClient(WithURL(server.URL+"/all_selectors.yml"), WithEd25519PublicKey(publicKey[:16]))
+
+		_, err := client.GetChainDetailsBySelector(ctx, 8888888888888888888)
+		var integrityErr *IntegrityError
+		require.True(t, errors.As(err, &integrityErr))
+		assert.ErrorIs(t, err, ErrInvalidSignature)
+		assert.ErrorContains(t, err, "expected a 32 byte ed25519 public key, got 16 bytes")
+	})
+
+	t.Run("custom signature URL", func(t *testing.T) {
+		server := newSignedServer(t, integrityYAML, signature)
+		client := NewClient(
+			WithURL(server.URL+"/all_selectors.yml"),
+			WithEd25519PublicKey(publicKey),
+			WithSignatureURL(server.URL+"/missing.sig"),
+		)
+		_, err := client.GetChainDetailsBySelector(ctx, 8888888888888888888)
+		assert.ErrorContains(t, err, "status code: 404")
+	})
+
+	t.Run("persisted payload is verified", func(t *testing.T) {
+		server := newSignedServer(t, integrityYAML, signature)
+		path := filepath.Join(t.TempDir(), "all_selectors.yml")
+		opts := []Option{WithURL(server.URL + "/all_selectors.yml"), WithEd25519PublicKey(publicKey), WithPersistPath(path)}
+
+		_, err := NewClient(opts...).GetChainDetailsBySelector(ctx, 8888888888888888888)
+		require.NoError(t, err)
+		assert.NotNil(t, NewClient(opts...).cache.Load())
+
+		require.NoError(t, os.WriteFile(path, []byte(integrityYAML+"  # tampered\n"), 0o600))
+		assert.Nil(t, NewClient(opts...).cache.Load())
+	})
+}
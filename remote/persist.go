@@ -0,0 +1,108 @@
+package remote
+
+import (
+	"log"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// loadPersisted loads the all_selectors.yml persisted by a previous client into the cache.
+// The data is considered fetched when the file was last written, so it is only served
+// while it is fresh or as stale data when a refresh fails.
+func (c *Client) loadPersisted() {
+	path := c.config.PersistPath
+
+	info, err := os.Stat(path)
+	if os.IsNotExist(err) {
+		return
+	}
+	if err != nil {
+		log.Printf("WARN: Failed to load persisted remote selectors from %s: %v", path, err)
+		return
+	}
+
+	body, err := os.ReadFile(path)
+	if err != nil {
+		log.Printf("WARN: Failed to load persisted remote selectors from %s: %v", path, err)
+		return
+	}
+
+	// The persisted payload is verified like a fetched one
+	var signature []byte
+	if len(c.config.PublicKey) > 0 {
+		signature, err = os.ReadFile(path + ".sig")
+		if err != nil && !os.IsNotExist(err) {
+			log.Printf("WARN: Failed to load persisted remote selectors from %s: %v", path, err)
+			return
+		}
+	}
+	if err := c.verify(path, body, signature); err != nil {
+		log.Printf("WARN: Failed to load persisted remote selectors from %s: %v", path, err)
+		return
+	}
+
+	data, stellarPassphrases, invalid, err := decodeRemoteSelectors(body)
+	if err != nil {
+		log.Printf("WARN: Failed to load persisted remote selectors from %s: %v", path, err)
+		return
+	}
+
+	// The persisted payload may be stale or tampered with, so it is guarded like a fetched one
+	quarantined, err := c.checkMutations(&data, stellarPassphrases, nil)
+	if err != nil {
+		log.Printf("WARN: Failed to load persisted remote selectors from %s: %v", path, err)
+		return
+	}
+
+	cache := newRemoteCacheData(data, stellarPassphrases)
+	cache.quarantined = quarantined
+	cache.invalid = invalid
+	cache.fetchedAt = info.ModTime()
+
+	c.cache.Store(cache)
+	// Later fetches are checked against the persisted chains and only notify subscribers of the changes since
+	c.publishLock.Lock()
+	c.published = cache
+	c.publishLock.Unlock()
+}
+
+// persist writes body, and its signature if any, to the persist path
+func (c *Client) persist(body, signature []byte) {
+	path := c.config.PersistPath
+
+	if err := writeFileAtomic(path, body); err != nil {
+		log.Printf("WARN: Failed to persist remote selectors to %s: %v", path, err)
+		return
+	}
+	if signature != nil {
+		if err := writeFileAtomic(path+".sig", signature); err != nil {
+			log.Printf("WARN: Failed to persist remote selectors signature to %s.sig: %v", path, err)
+		}
+	}
+}
+
+// writeFileAtomic writes data to path, replacing the previous file atomically
+func writeFileAtomic(path string, data []byte) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+// touchPersisted marks the persisted file as fetched at t after the remote reported it unchanged
+func (c *Client) touchPersisted(t time.Time) {
+	if err := os.Chtimes(c.config.PersistPath, t, t); err != nil && !os.IsNotExist(err) {
+		log.Printf("WARN: Failed to update persisted remote selectors at %s: %v", c.config.PersistPath, err)
+	}
+}
@@ -0,0 +1,177 @@
+package remote
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const persistYAML = `
+evm:
+  4242424242:
+    selector: 8888888888888888888
+    name: test-persisted-chain
+`
+
+// newFlakyServer returns a server that serves persistYAML until failing is set
+func newFlakyServer(t *testing.T) (*httptest.Server, *atomic.Bool) {
+	var failing atomic.Bool
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if failing.Load() {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(persistYAML))
+	}))
+	t.Cleanup(server.Close)
+	return server, &failing
+}
+
+func TestServeStaleOnError(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("serves expired data when the refresh fails", func(t *testing.T) {
+		server, failing := newFlakyServer(t)
+		client := NewClient(WithURL(server.URL), WithCacheTTL(time.Millisecond), WithServeStaleOnError())
+
+		_, err := client.GetChainDetailsBySelector(ctx, 8888888888888888888)
+		require.NoError(t, err)
+		status := client.CacheStatus()
+		assert.NoError(t, status.LastError)
+
+		failing.Store(true)
+		time.Sleep(5 * time.Millisecond)
+
+		details, err := client.GetChainDetailsBySelector(ctx, 8888888888888888888)
+		require.NoError(t, err)
+		assert.Equal(t, "test-persisted-chain", details.ChainName)
+
+		status = client.CacheStatus()
+		assert.True(t, status.Stale)
+		assert.ErrorContains(t, status.LastError, "status code: 503")
+
+		failing.Store(false)
+		_, err = client.fetchRemoteSelectors(ctx)
+		require.NoError(t, err)
+		assert.NoError(t, client.CacheStatus().LastError)
+	})
+
+	t.Run("fails without the option", func(t *testing.T) {
+		server, failing := newFlakyServer(t)
+		client := NewClient(WithURL(server.URL), WithCacheTTL(time.Millisecond))
+
+		_, err := client.GetChainDetailsBySelector(ctx, 8888888888888888888)
+		require.NoError(t, err)
+
+		failing.Store(true)
+		time.Sleep(5 * time.Millisecond)
+
+		_, err = client.GetChainDetailsBySelector(ctx, 8888888888888888888)
+		assert.Error(t, err)
+	})
+}
+
+func TestPersistPath(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "all_selectors.yml")
+
+	server, failing := newFlakyServer(t)
+	client := NewClient(WithURL(server.URL), WithPersistPath(path))
+	_, err := client.GetChainDetailsBySelector(ctx, 8888888888888888888)
+	require.NoError(t, err)
+
+	persisted, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, persistYAML, string(persisted))
+
+	t.Run("fresh persisted data is served without fetching", func(t *testing.T) {
+		failing.Store(true)
+		t.Cleanup(func() { failing.Store(false) })
+
+		restarted := NewClient(WithURL(server.URL), WithPersistPath(path))
+		details, err := restarted.GetChainDetailsBySelector(ctx, 8888888888888888888)
+		require.NoError(t, err)
+		assert.Equal(t, "test-persisted-chain", details.ChainName)
+	})
+
+	t.Run("expired persisted data is served when the remote is down", func(t *testing.T) {
+		old := time.Now().Add(-time.Hour)
+		require.NoError(t, os.Chtimes(path, old, old))
+		server.Close()
+
+		restarted := NewClient(WithURL(server.URL), WithPersistPath(path), WithServeStaleOnError())
+		details, err := restarted.GetChainDetailsBySelector(ctx, 8888888888888888888)
+		require.NoError(t, err)
+		assert.Equal(t, "test-persisted-chain", details.ChainName)
+
+		status := restarted.CacheStatus()
+		assert.True(t, status.Stale)
+		assert.WithinDuration(t, old, status.FetchedAt, time.Second)
+		assert.Error(t, status.LastError)
+
+		_, err = NewClient(WithURL(server.URL), WithPersistPath(path)).GetChainDetailsBySelector(ctx, 8888888888888888888)
+		assert.Error(t, err)
+	})
+
+	t.Run("missing or invalid file is ignored", func(t *testing.T) {
+		assert.Nil(t, NewClient(WithPersistPath(filepath.Join(t.TempDir(), "missing.yml"))).cache.Load())
+
+		invalid := filepath.Join(t.TempDir(), "invalid.yml")
+		require.NoError(t, os.WriteFile(invalid, []byte("invalid: yaml: ["), 0o600))
+		assert.Nil(t, NewClient(WithPersistPath(invalid)).cache.Load())
+	})
+}
+
+func TestPersistedDataIsGuarded(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "all_selectors.yml")
+	require.NoError(t, os.WriteFile(path, []byte(persistYAML+`
+  1:
+    selector: 8888888888888888889
+    name: ethereum-mainnet
+`), 0o600))
+
+	t.Run("rejected by default", func(t *testing.T) {
+		assert.Nil(t, NewClient(WithPersistPath(path)).cache.Load())
+	})
+
+	t.Run("quarantined with MutationPolicyQuarantine", func(t *testing.T) {
+		var requests atomic.Int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests.Add(1)
+			w.Write([]byte(`
+evm:
+  4242424242:
+    selector: 6666666666666666666
+    name: test-persisted-chain
+`))
+		}))
+		t.Cleanup(server.Close)
+
+		client := NewClient(WithURL(server.URL), WithPersistPath(path), WithMutationPolicy(MutationPolicyQuarantine))
+		quarantined := client.CacheStatus().Quarantined
+		require.Len(t, quarantined, 1)
+		assert.Equal(t, "1", quarantined[0].Fetched.ChainID)
+
+		details, err := client.GetChainDetailsBySelector(ctx, 8888888888888888888)
+		require.NoError(t, err)
+		assert.Equal(t, "test-persisted-chain", details.ChainName)
+		assert.Zero(t, requests.Load())
+
+		// The next fetch is checked against the persisted chains
+		_, err = client.fetch(ctx)
+		require.NoError(t, err)
+		quarantined = client.CacheStatus().Quarantined
+		require.Len(t, quarantined, 1)
+		assert.Equal(t, "cached", quarantined[0].Source)
+	})
+}
@@ -0,0 +1,240 @@
+package remote
+
+import (
+	"context"
+	"errors"
+	"log"
+	"sort"
+	"time"
+
+	chain_selectors "github.com/smartcontractkit/chain-selectors"
+)
+
+// Diff describes how the remote data changed between two fetches.
+// Chains are sorted by family and chain ID.
+type Diff struct {
+	// Added holds chains that were not present in the previous data
+	Added []ChainDetailsWithMetadata
+	// Deprecated holds chains that were marked as deprecated since the previous data
+	Deprecated []ChainDetailsWithMetadata
+	// Changed holds chains whose selector, name or network type changed since the previous data
+	Changed []ChainChange
+	// Removed holds chains that are no longer present
+	Removed []ChainDetailsWithMetadata
+}
+
+// ChainChange holds the previous and current details of a chain that changed.
+type ChainChange struct {
+	Family   string
+	ChainID  string
+	Previous chain_selectors.ChainDetails
+	Current  chain_selectors.ChainDetails
+}
+
+// IsEmpty reports whether the diff holds no changes.
+func (d Diff) IsEmpty() bool {
+	return len(d.Added) == 0 && len(d.Deprecated) == 0 && len(d.Changed) == 0 && len(d.Removed) == 0
+}
+
+// Subscribe registers fn to be called with the changes every time the client fetches data that differs
+// from the data it fetched before. The first fetch reports every chain as added.
+// Calls are made sequentially from the goroutine that fetched the data while other fetches wait, so fn should
+// return quickly and must not make lookups that fetch data.
+// The returned function unsubscribes fn.
+func (c *Client) Subscribe(fn func(Diff)) (unsubscribe func()) {
+	c.subscribersLock.Lock()
+	defer c.subscribersLock.Unlock()
+
+	if c.subscribers == nil {
+		c.subscribers = make(map[int]func(Diff))
+	}
+	id := c.nextSubscriber
+	c.nextSubscriber++
+	c.subscribers[id] = fn
+
+	return func() {
+		c.subscribersLock.Lock()
+		defer c.subscribersLock.Unlock()
+		delete(c.subscribers, id)
+	}
+}
+
+// publish notifies subscribers of the changes between the previously published data and data.
+// c.publishLock must be held.
+func (c *Client) publish(data *remoteCacheData) {
+	diff := diffRemoteData(c.published, data)
+	c.published = data
+	if diff.IsEmpty() {
+		return
+	}
+
+	c.subscribersLock.Lock()
+	ids := make([]int, 0, len(c.subscribers))
+	for id := range c.subscribers {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+	subscribers := make([]func(Diff), 0, len(ids))
+	for _, id := range ids {
+		subscribers = append(subscribers, c.subscribers[id])
+	}
+	c.subscribersLock.Unlock()
+
+	for _, fn := range subscribers {
+		fn(diff)
+	}
+}
+
+// Start refreshes the remote data in the background every RefreshInterval until ctx is done or Close is called.
+// The first refresh happens immediately. Failed refreshes are logged and retried at the next interval.
+// It fails if caching is disabled, as lookups would then fetch the data themselves and ignore the refreshed data.
+func (c *Client) Start(ctx context.Context) error {
+	if c.config.RefreshInterval <= 0 {
+		return errors.New("refresh interval must be set to start background refresh")
+	}
+	if c.config.CacheTTL <= 0 {
+		return errors.New("cache TTL must be set to start background refresh")
+	}
+
+	c.refreshLock.Lock()
+	defer c.refreshLock.Unlock()
+
+	if c.refreshStop != nil {
+		return errors.New("background refresh already started")
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	done := make(chan struct{})
+	c.refreshStop = cancel
+	c.refreshDone = done
+
+	go func() {
+		defer close(done)
+		c.refreshLoop(ctx)
+	}()
+	return nil
+}
+
+// Close stops the background refresh started by Start and waits for it to exit.
+func (c *Client) Close() {
+	c.refreshLock.Lock()
+	defer c.refreshLock.Unlock()
+
+	if c.refreshStop == nil {
+		return
+	}
+	c.refreshStop()
+	<-c.refreshDone
+	c.refreshStop = nil
+	c.refreshDone = nil
+}
+
+func (c *Client) refreshLoop(ctx context.Context) {
+	ticker := time.NewTicker(c.config.RefreshInterval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := c.fetch(ctx); err != nil && ctx.Err() == nil {
+			log.Printf("WARN: Failed to refresh remote selectors from %s: %v", c.config.URL, err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// chainKey identifies a chain across families
+type chainKey struct {
+	family  string
+	chainID string
+}
+
+// diffRemoteData returns the changes between previous and current, treating a nil previous as empty
+func diffRemoteData(previous, current *remoteCacheData) Diff {
+	prevChains := previous.chains()
+	currChains := current.chains()
+
+	var diff Diff
+	for key, curr := range currChains {
+		prev, exists := prevChains[key]
+		if !exists {
+			diff.Added = append(diff.Added, curr)
+			continue
+		}
+		if curr.Deprecated && !prev.Deprecated {
+			diff.Deprecated = append(diff.Deprecated, curr)
+		}
+		if curr.ChainSelector != prev.ChainSelector || curr.ChainName != prev.ChainName || curr.NetworkType != prev.NetworkType {
+			diff.Changed = append(diff.Changed, ChainChange{
+				Family:   key.family,
+				ChainID:  key.chainID,
+				Previous: prev.ChainDetails,
+				Current:  curr.ChainDetails,
+			})
+		}
+	}
+	for key, prev := range prevChains {
+		if _, exists := currChains[key]; !exists {
+			diff.Removed = append(diff.Removed, prev)
+		}
+	}
+
+	sortChains(diff.Added)
+	sortChains(diff.Deprecated)
+	sortChains(diff.Removed)
+	sort.Slice(diff.Changed, func(i, j int) bool {
+		if diff.Changed[i].Family != diff.Changed[j].Family {
+			return diff.Changed[i].Family < diff.Changed[j].Family
+		}
+		return diff.Changed[i].ChainID < diff.Changed[j].ChainID
+	})
+	return diff
+}
+
+func sortChains(chains []ChainDetailsWithMetadata) {
+	sort.Slice(chains, func(i, j int) bool {
+		if chains[i].Family != chains[j].Family {
+			return chains[i].Family < chains[j].Family
+		}
+		return chains[i].ChainID < chains[j].ChainID
+	})
+}
+
+// chains returns every chain in the data keyed by family and chain ID
+func (d *remoteCacheData) chains() map[chainKey]ChainDetailsWithMetadata {
+	result := make(map[chainKey]ChainDetailsWithMetadata)
+	if d == nil {
+		return result
+	}
+
+	forEachChain(d.data(), func(chain ChainDetailsWithMetadata) {
+		result[chainKey{family: chain.Family, chainID: chain.ChainID}] = chain
+	})
+	return result
+}
+
+// data returns the chains of every family. The maps must not be modified.
+func (d *remoteCacheData) data() chain_selectors.ExtraSelectorsData {
+	return chain_selectors.ExtraSelectorsData{
+		Evm:      d.evmChainIdToChainSelector,
+		Solana:   d.solanaChainIdToChainSelector,
+		Aptos:    d.aptosSelectorsMap,
+		Sui:      d.suiSelectorsMap,
+		Ton:      d.tonSelectorsMap,
+		Tron:     d.tronSelectorsMap,
+		Starknet: d.starknetSelectorsMap,
+		Canton:   d.cantonSelectorsMap,
+		Stellar:  d.stellarSelectorsMap,
+		Cosmos:   d.cosmosSelectorsMap,
+	}
+}
+
+// forEachChain calls fn for every chain in data with its chain ID formatted as a string
+func forEachChain(data chain_selectors.ExtraSelectorsData, fn func(ChainDetailsWithMetadata)) {
+	data.ForEach(func(family, chainID string, details chain_selectors.ChainDetails) {
+		fn(ChainDetailsWithMetadata{ChainDetails: details, Family: family, ChainID: chainID})
+	})
+}
@@ -0,0 +1,152 @@
+package remote
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	chain_selectors "github.com/smartcontractkit/chain-selectors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiffRemoteData(t *testing.T) {
+	previous, err := parseRemoteSelectors([]byte(`
+evm:
+  1:
+    selector: 5009297550715157269
+    name: ethereum-mainnet
+  4242424242:
+    selector: 8888888888888888888
+    name: old-name
+ton:
+  -239:
+    selector: 16448340667252469081
+    name: ton-mainnet
+`))
+	require.NoError(t, err)
+
+	current, err := parseRemoteSelectors([]byte(`
+evm:
+  1:
+    selector: 5009297550715157269
+    name: ethereum-mainnet
+    deprecated: true
+  4242424242:
+    selector: 8888888888888888888
+    name: new-name
+cosmos:
+  cosmoshub-4:
+    selector: 8703667408786976009
+    name: cosmoshub-mainnet
+`))
+	require.NoError(t, err)
+
+	diff := diffRemoteData(previous, current)
+	require.Len(t, diff.Added, 1)
+	assert.Equal(t, chain_selectors.FamilyCosmos, diff.Added[0].Family)
+	assert.Equal(t, "cosmoshub-4", diff.Added[0].ChainID)
+
+	require.Len(t, diff.Deprecated, 1)
+	assert.Equal(t, "1", diff.Deprecated[0].ChainID)
+
+	require.Len(t, diff.Changed, 1)
+	assert.Equal(t, "old-name", diff.Changed[0].Previous.ChainName)
+	assert.Equal(t, "new-name", diff.Changed[0].Current.ChainName)
+
+	require.Len(t, diff.Removed, 1)
+	assert.Equal(t, chain_selectors.FamilyTon, diff.Removed[0].Family)
+	assert.Equal(t, "-239", diff.Removed[0].ChainID)
+
+	assert.True(t, diffRemoteData(current, current).IsEmpty())
+	assert.Len(t, diffRemoteData(nil, current).Added, 3)
+}
+
+func TestClientBackgroundRefresh(t *testing.T) {
+	var (
+		mu   sync.Mutex
+		body = `
+evm:
+  4242424242:
+    selector: 8888888888888888888
+    name: test-refresh-chain
+`
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(body))
+	}))
+	t.Cleanup(server.Close)
+
+	client := NewClient(
+		WithURL(server.URL),
+		WithCacheTTL(time.Minute),
+		WithRefreshInterval(10*time.Millisecond),
+	)
+
+	diffs := make(chan Diff, 10)
+	unsubscribe := client.Subscribe(func(diff Diff) {
+		diffs <- diff
+	})
+	defer unsubscribe()
+
+	require.NoError(t, client.Start(context.Background()))
+	t.Cleanup(client.Close)
+	assert.Error(t, client.Start(context.Background()), "starting twice must fail")
+
+	select {
+	case diff := <-diffs:
+		require.Len(t, diff.Added, 1)
+		assert.Equal(t, "test-refresh-chain", diff.Added[0].ChainName)
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for initial data")
+	}
+
+	mu.Lock()
+	body = `
+evm:
+  4242424242:
+    selector: 8888888888888888888
+    name: test-refresh-chain
+    deprecated: true
+`
+	mu.Unlock()
+
+	select {
+	case diff := <-diffs:
+		assert.Empty(t, diff.Added)
+		require.Len(t, diff.Deprecated, 1)
+		assert.Equal(t, uint64(8888888888888888888), diff.Deprecated[0].ChainSelector)
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for refreshed data")
+	}
+
+	// Lookups are served from the refreshed cache
+	details, err := client.GetChainDetailsBySelector(context.Background(), 8888888888888888888)
+	require.NoError(t, err)
+	assert.True(t, details.Deprecated)
+
+	client.Close()
+	client.Close()
+}
+
+func TestClientStartRequiresRefreshInterval(t *testing.T) {
+	client := NewClient()
+	assert.Error(t, client.Start(context.Background()))
+	client.Close()
+}
+
+func TestClientStartRequiresCacheTTL(t *testing.T) {
+	client := NewClient(WithRefreshInterval(time.Minute), WithCacheTTL(0))
+	assert.Error(t, client.Start(context.Background()))
+
+	// Serving stale data doesn't help, as lookups still fetch the data themselves
+	client = NewClient(WithRefreshInterval(time.Minute), WithCacheTTL(0), WithServeStaleOnError())
+	assert.Error(t, client.Start(context.Background()))
+	client.Close()
+}
@@ -0,0 +1,28 @@
+package remote
+
+import (
+	"context"
+
+	chain_selectors "github.com/smartcontractkit/chain-selectors"
+)
+
+// Registry returns a registry holding the chains of the default registry and the fetched chains,
+// so that the remote data can be used wherever a chain_selectors.Registry is. Like the other lookups
+// of the client, local chains take precedence over fetched chains with the same chain ID.
+func (c *Client) Registry(ctx context.Context) (*chain_selectors.Registry, error) {
+	cache, err := c.fetchRemoteSelectors(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	r := chain_selectors.DefaultRegistry().Clone()
+	if _, err := r.MergeExtraSelectors(cache.data(), chain_selectors.ConflictPolicySkip); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// Registry returns a registry holding the local and the fetched chains, see Client.Registry.
+func Registry(ctx context.Context, opts ...Option) (*chain_selectors.Registry, error) {
+	return clientFor(opts).Registry(ctx)
+}
@@ -0,0 +1,33 @@
+package remote
+
+import (
+	"context"
+	"testing"
+
+	chain_selectors "github.com/smartcontractkit/chain-selectors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClientRegistry(t *testing.T) {
+	server := newMockServer()
+	defer server.Close()
+
+	r, err := NewClient(WithURL(server.URL)).Registry(context.Background())
+	require.NoError(t, err)
+
+	// Remote only chains are added
+	details, err := r.GetChainDetailsByNetworkName("remote-only-mainnet")
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1777777777777777777), details.ChainSelector)
+	assert.True(t, details.Deprecated)
+
+	// Local chains take precedence
+	details, err = r.GetChainDetailsByChainIDAndFamily("10", chain_selectors.FamilyEVM)
+	require.NoError(t, err)
+	assert.Equal(t, chain_selectors.ETHEREUM_MAINNET_OPTIMISM_1.Name, details.ChainName)
+
+	// The default registry is not modified
+	_, err = chain_selectors.GetChainDetails(1777777777777777777)
+	assert.ErrorIs(t, err, chain_selectors.ErrUnknownSelector)
+}
@@ -2,11 +2,12 @@ package remote
 
 import (
 	"context"
+	"crypto/ed25519"
 	"fmt"
-	"io"
+	"log"
 	"net/http"
+	"sort"
 	"strconv"
-	"sync"
 	"time"
 
 	chain_selectors "github.com/smartcontractkit/chain-selectors"
@@ -22,12 +23,6 @@ const (
 	DefaultCacheTTL = 5 * time.Minute
 )
 
-var (
-	// remoteCache stores the parsed remote data to avoid repeated HTTP calls
-	remoteCache     *remoteCacheData
-	remoteCacheLock sync.RWMutex
-)
-
 type remoteCacheData struct {
 	// EVM
 	evmChainIdToChainSelector map[uint64]chain_selectors.ChainDetails
@@ -43,18 +38,34 @@ type remoteCacheData struct {
 	suiSelectorsMap     map[uint64]chain_selectors.ChainDetails
 	suiChainsBySelector map[uint64]chain_selectors.SuiChain
 	// Ton
-	tonSelectorsMap      map[int32]chain_selectors.ChainDetails
-	tonChainIdBySelector map[uint64]int32
+	tonSelectorsMap     map[int32]chain_selectors.ChainDetails
+	tonChainsBySelector map[uint64]chain_selectors.TonChain
 	// Tron
-	tronSelectorsMap      map[uint64]chain_selectors.ChainDetails
-	tronChainIdBySelector map[uint64]uint64
+	tronSelectorsMap     map[uint64]chain_selectors.ChainDetails
+	tronChainsBySelector map[uint64]chain_selectors.TronChain
 	// Starknet
 	starknetSelectorsMap     map[string]chain_selectors.ChainDetails
 	starknetChainsBySelector map[uint64]chain_selectors.StarknetChain
 	// Canton
 	cantonSelectorsMap map[string]chain_selectors.ChainDetails
+	// Cosmos
+	cosmosSelectorsMap map[string]chain_selectors.ChainDetails
+	// Stellar
+	stellarSelectorsMap        map[string]chain_selectors.ChainDetails
+	stellarChainsBySelector    map[uint64]chain_selectors.StellarChain
+	stellarChainIdToPassphrase map[string]string
+	// Indexes over every family
+	chainsBySelector  map[uint64]ChainDetailsWithMetadata
+	evmChainIDsByName map[string]uint64
 	// Metadata
 	fetchedAt time.Time
+	// Validators used to make conditional requests for the same data
+	etag         string
+	lastModified string
+	// Chains dropped from the data by MutationPolicyQuarantine
+	quarantined []Mutation
+	// Chains dropped from the data because they failed validation
+	invalid []chain_selectors.ValidationError
 }
 
 // Config holds configuration for remote API calls
@@ -68,6 +79,30 @@ type Config struct {
 	// CacheTTL is the time-to-live for cached remote data
 	// If zero, no caching will be used (always fetch fresh data)
 	CacheTTL time.Duration
+	// HTTPClient is the HTTP client used to fetch the remote data
+	// If nil, a client with Timeout will be used
+	HTTPClient *http.Client
+	// RefreshInterval is the interval at which Client.Start refreshes the remote data in the background
+	// If zero, the remote data is only fetched when a call finds the cache expired
+	// Client.Start requires CacheTTL to be set, so lookups are served from the refreshed data
+	RefreshInterval time.Duration
+	// ServeStaleOnError keeps serving the last fetched data after it expires when a refresh fails
+	ServeStaleOnError bool
+	// PersistPath is a file the last successfully fetched all_selectors.yml is written to
+	// and loaded from when the client is created. If empty, nothing is persisted.
+	PersistPath string
+	// SHA256 is the hex encoded SHA-256 digest the payload must match
+	// If empty, the digest is not checked
+	SHA256 string
+	// PublicKey is the ed25519 public key the payload's detached signature must verify against
+	// If empty, the signature is not checked
+	PublicKey ed25519.PublicKey
+	// SignatureURL is the URL of the payload's detached signature
+	// If empty, URL with a ".sig" suffix will be used
+	SignatureURL string
+	// MutationPolicy decides what happens to fetched data that changes the selector or family of a known chain
+	// The default, MutationPolicyReject, rejects the whole payload
+	MutationPolicy MutationPolicy
 }
 
 // Option is a functional option for configuring remote API calls
@@ -97,56 +132,149 @@ func WithCacheTTL(ttl time.Duration) Option {
 	}
 }
 
-// fetchRemoteSelectors fetches and parses the all_selectors.yml file from GitHub
-func fetchRemoteSelectors(ctx context.Context, config *Config) (*remoteCacheData, error) {
-	// Check cache first if TTL is set
-	if config.CacheTTL > 0 {
-		remoteCacheLock.RLock()
-		if remoteCache != nil && time.Since(remoteCache.fetchedAt) < config.CacheTTL {
-			cached := remoteCache
-			remoteCacheLock.RUnlock()
-			return cached, nil
-		}
-		remoteCacheLock.RUnlock()
+// WithHTTPClient sets the HTTP client used to fetch the remote data.
+// If not provided, a client using the configured timeout will be used.
+// Package level calls with an HTTP client don't share a client, so nothing is cached between them:
+// create a Client with NewClient to keep the data.
+func WithHTTPClient(client *http.Client) Option {
+	return func(c *Config) {
+		c.HTTPClient = client
 	}
+}
 
-	url := config.URL
-	timeout := config.Timeout
+// WithRefreshInterval sets the interval at which Client.Start refreshes the remote data in the background.
+// It should be shorter than the cache TTL so calls never find the cache expired. Client.Start fails if the cache
+// TTL is zero.
+func WithRefreshInterval(interval time.Duration) Option {
+	return func(c *Config) {
+		c.RefreshInterval = interval
+	}
+}
 
-	// Create HTTP client with timeout
-	client := &http.Client{
-		Timeout: timeout,
+// WithServeStaleOnError keeps serving the last fetched data after it expires when a refresh fails.
+// Use Client.CacheStatus to find out whether the data being served is stale, which requires creating
+// the Client with NewClient rather than passing the option to the package level functions.
+func WithServeStaleOnError() Option {
+	return func(c *Config) {
+		c.ServeStaleOnError = true
 	}
+}
 
-	// Create request with context
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create HTTP request: %w", err)
+// WithPersistPath sets a file the last successfully fetched all_selectors.yml is written to.
+// The file is loaded when the client is created, so combined with WithServeStaleOnError
+// chains keep resolving when the remote is unreachable after a restart.
+func WithPersistPath(path string) Option {
+	return func(c *Config) {
+		c.PersistPath = path
 	}
+}
 
-	// Execute request
-	resp, err := client.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("failed to fetch remote selectors from %s: %w", url, err)
+// WithSHA256 pins the hex encoded SHA-256 digest of the payload.
+// Payloads with a different digest are rejected with an IntegrityError.
+func WithSHA256(digest string) Option {
+	return func(c *Config) {
+		c.SHA256 = digest
+	}
+}
+
+// WithEd25519PublicKey requires the payload to have a detached ed25519 signature made with the key's private key.
+// The signature is fetched from the URL set with WithSignatureURL, by default the payload URL with a ".sig" suffix,
+// and may be raw or base64 or hex encoded. Payloads failing verification are rejected with an IntegrityError,
+// as are all payloads if the key is not ed25519.PublicKeySize bytes long.
+func WithEd25519PublicKey(key ed25519.PublicKey) Option {
+	return func(c *Config) {
+		c.PublicKey = key
+	}
+}
+
+// WithSignatureURL sets the URL of the payload's detached signature.
+// If not provided, the payload URL with a ".sig" suffix will be used.
+func WithSignatureURL(url string) Option {
+	return func(c *Config) {
+		c.SignatureURL = url
 	}
-	defer resp.Body.Close()
+}
 
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("failed to fetch remote selectors, status code: %d", resp.StatusCode)
+// WithMutationPolicy sets what happens to fetched data that changes the selector or family of a chain
+// known from the embedded data or previously fetched data.
+// If not provided, MutationPolicyReject will be used.
+func WithMutationPolicy(policy MutationPolicy) Option {
+	return func(c *Config) {
+		c.MutationPolicy = policy
 	}
+}
 
-	// Read response body
-	body, err := io.ReadAll(resp.Body)
+// parseRemoteSelectors parses the all_selectors.yml file and builds the lookup maps
+func parseRemoteSelectors(body []byte) (*remoteCacheData, error) {
+	data, stellarPassphrases, invalid, err := decodeRemoteSelectors(body)
 	if err != nil {
-		return nil, fmt.Errorf("failed to read response body: %w", err)
+		return nil, err
 	}
+	cache := newRemoteCacheData(data, stellarPassphrases)
+	cache.invalid = invalid
+	return cache, nil
+}
 
+// decodeRemoteSelectors parses the all_selectors.yml file into the chains of every family
+// and the Stellar network passphrases keyed by chain ID. Invalid chains are left out and returned.
+func decodeRemoteSelectors(body []byte) (chain_selectors.ExtraSelectorsData, map[string]string, []chain_selectors.ValidationError, error) {
 	// Parse YAML
 	var data chain_selectors.ExtraSelectorsData
 	if err := yaml.Unmarshal(body, &data); err != nil {
-		return nil, fmt.Errorf("failed to parse remote selectors YAML: %w", err)
+		return data, nil, nil, newLookupError(ErrRemoteFetch, err, "failed to parse remote selectors YAML: %v", err)
+	}
+
+	// Stellar network passphrases are not part of ChainDetails, so they are parsed separately
+	var stellarData struct {
+		Stellar map[string]struct {
+			Passphrase string `yaml:"passphrase"`
+		} `yaml:"stellar"`
+	}
+	if err := yaml.Unmarshal(body, &stellarData); err != nil {
+		return data, nil, nil, newLookupError(ErrRemoteFetch, err, "failed to parse remote selectors YAML: %v", err)
+	}
+
+	stellarPassphrases := make(map[string]string, len(stellarData.Stellar))
+	for chainID, v := range stellarData.Stellar {
+		stellarPassphrases[chainID] = v.Passphrase
+	}
+	invalid := validateRemoteSelectors(&data, stellarPassphrases)
+	return data, stellarPassphrases, invalid, nil
+}
+
+// validateRemoteSelectors checks the chains with the rules used for the embedded and extra selectors,
+// and that Stellar chain IDs are the SHA-256 hash of their passphrase. Like the lenient extra selectors,
+// the invalid chains are removed from data and returned, so that one bad entry doesn't break every lookup.
+func validateRemoteSelectors(data *chain_selectors.ExtraSelectorsData, stellarPassphrases map[string]string) []chain_selectors.ValidationError {
+	invalid := chain_selectors.Validate(*data)
+	chainIDs := make([]string, 0, len(stellarPassphrases))
+	for chainID := range stellarPassphrases {
+		chainIDs = append(chainIDs, chainID)
+	}
+	sort.Strings(chainIDs)
+	for _, chainID := range chainIDs {
+		passphrase := stellarPassphrases[chainID]
+		if passphrase != "" && chain_selectors.StellarChainIDFromPassphrase(passphrase) != chainID {
+			invalid = append(invalid, chain_selectors.ValidationError{
+				Family:  chain_selectors.FamilyStellar,
+				ChainID: chainID,
+				Err:     fmt.Errorf("%w: must be the SHA-256 hash of passphrase %q", chain_selectors.ErrInvalidChainID, passphrase),
+			})
+		}
 	}
 
+	for _, err := range invalid {
+		log.Printf("WARN: Dropping invalid remote selector: %v", err)
+		data.Remove(err.Family, err.ChainID)
+		if err.Family == chain_selectors.FamilyStellar {
+			delete(stellarPassphrases, err.ChainID)
+		}
+	}
+	return invalid
+}
+
+// newRemoteCacheData builds the lookup maps for the given chains
+func newRemoteCacheData(data chain_selectors.ExtraSelectorsData, stellarPassphrases map[string]string) *remoteCacheData {
 	// Build cache data structure
 	cache := &remoteCacheData{
 		evmChainIdToChainSelector:    data.Evm,
@@ -159,32 +287,49 @@ func fetchRemoteSelectors(ctx context.Context, config *Config) (*remoteCacheData
 		suiSelectorsMap:              data.Sui,
 		suiChainsBySelector:          make(map[uint64]chain_selectors.SuiChain),
 		tonSelectorsMap:              data.Ton,
-		tonChainIdBySelector:         make(map[uint64]int32),
+		tonChainsBySelector:          make(map[uint64]chain_selectors.TonChain),
 		tronSelectorsMap:             data.Tron,
-		tronChainIdBySelector:        make(map[uint64]uint64),
+		tronChainsBySelector:         make(map[uint64]chain_selectors.TronChain),
 		starknetSelectorsMap:         data.Starknet,
 		starknetChainsBySelector:     make(map[uint64]chain_selectors.StarknetChain),
 		cantonSelectorsMap:           data.Canton,
+		cosmosSelectorsMap:           data.Cosmos,
+		stellarSelectorsMap:          data.Stellar,
+		stellarChainsBySelector:      make(map[uint64]chain_selectors.StellarChain),
+		stellarChainIdToPassphrase:   make(map[string]string),
+		chainsBySelector:             make(map[uint64]ChainDetailsWithMetadata),
+		evmChainIDsByName:            make(map[string]uint64, len(data.Evm)),
 		fetchedAt:                    time.Now(),
 	}
 
+	forEachChain(data, func(chain ChainDetailsWithMetadata) {
+		cache.chainsBySelector[chain.ChainSelector] = chain
+	})
+
 	// Build EVM lookup maps
 	for chainID, details := range data.Evm {
 		chain := chain_selectors.Chain{
-			EvmChainID: chainID,
-			Selector:   details.ChainSelector,
-			Name:       details.ChainName,
+			EvmChainID:  chainID,
+			Selector:    details.ChainSelector,
+			Name:        details.ChainName,
+			NetworkType: details.NetworkType,
+			Deprecated:  details.Deprecated,
 		}
 		cache.evmChainsBySelector[details.ChainSelector] = chain
 		cache.evmChainsByEvmChainID[chainID] = chain
+		if details.ChainName != "" {
+			cache.evmChainIDsByName[details.ChainName] = chainID
+		}
 	}
 
 	// Build Solana lookup maps
 	for chainID, details := range data.Solana {
 		chain := chain_selectors.SolanaChain{
-			ChainID:  chainID,
-			Selector: details.ChainSelector,
-			Name:     details.ChainName,
+			ChainID:     chainID,
+			Selector:    details.ChainSelector,
+			Name:        details.ChainName,
+			NetworkType: details.NetworkType,
+			Deprecated:  details.Deprecated,
 		}
 		cache.solanaChainsBySelector[details.ChainSelector] = chain
 	}
@@ -192,9 +337,11 @@ func fetchRemoteSelectors(ctx context.Context, config *Config) (*remoteCacheData
 	// Build Aptos lookup maps
 	for chainID, details := range data.Aptos {
 		chain := chain_selectors.AptosChain{
-			ChainID:  chainID,
-			Selector: details.ChainSelector,
-			Name:     details.ChainName,
+			ChainID:     chainID,
+			Selector:    details.ChainSelector,
+			Name:        details.ChainName,
+			NetworkType: details.NetworkType,
+			Deprecated:  details.Deprecated,
 		}
 		cache.aptosChainsBySelector[details.ChainSelector] = chain
 	}
@@ -202,41 +349,68 @@ func fetchRemoteSelectors(ctx context.Context, config *Config) (*remoteCacheData
 	// Build Sui lookup maps
 	for chainID, details := range data.Sui {
 		chain := chain_selectors.SuiChain{
-			ChainID:  chainID,
-			Selector: details.ChainSelector,
-			Name:     details.ChainName,
+			ChainID:     chainID,
+			Selector:    details.ChainSelector,
+			Name:        details.ChainName,
+			NetworkType: details.NetworkType,
+			Deprecated:  details.Deprecated,
 		}
 		cache.suiChainsBySelector[details.ChainSelector] = chain
 	}
 
 	// Build Ton lookup maps
 	for chainID, details := range data.Ton {
-		cache.tonChainIdBySelector[details.ChainSelector] = chainID
+		chain := chain_selectors.TonChain{
+			ChainID:     chainID,
+			Selector:    details.ChainSelector,
+			Name:        details.ChainName,
+			NetworkType: details.NetworkType,
+			Deprecated:  details.Deprecated,
+		}
+		cache.tonChainsBySelector[details.ChainSelector] = chain
 	}
 
 	// Build Tron lookup maps
 	for chainID, details := range data.Tron {
-		cache.tronChainIdBySelector[details.ChainSelector] = chainID
+		chain := chain_selectors.TronChain{
+			ChainID:     chainID,
+			Selector:    details.ChainSelector,
+			Name:        details.ChainName,
+			NetworkType: details.NetworkType,
+			Deprecated:  details.Deprecated,
+		}
+		cache.tronChainsBySelector[details.ChainSelector] = chain
 	}
 
 	// Build Starknet lookup maps
 	for chainID, details := range data.Starknet {
 		chain := chain_selectors.StarknetChain{
-			ChainID:  chainID,
-			Selector: details.ChainSelector,
-			Name:     details.ChainName,
+			ChainID:     chainID,
+			Selector:    details.ChainSelector,
+			Name:        details.ChainName,
+			NetworkType: details.NetworkType,
+			Deprecated:  details.Deprecated,
 		}
 		cache.starknetChainsBySelector[details.ChainSelector] = chain
 	}
 
-	// Update cache if TTL is set
-	if config.CacheTTL > 0 {
-		remoteCacheLock.Lock()
-		remoteCache = cache
-		remoteCacheLock.Unlock()
+	// Build Stellar lookup maps
+	for chainID, passphrase := range stellarPassphrases {
+		cache.stellarChainIdToPassphrase[chainID] = passphrase
+	}
+	for chainID, details := range data.Stellar {
+		chain := chain_selectors.StellarChain{
+			ChainID:     chainID,
+			Selector:    details.ChainSelector,
+			Name:        details.ChainName,
+			NetworkType: details.NetworkType,
+			Deprecated:  details.Deprecated,
+			Passphrase:  cache.stellarChainIdToPassphrase[chainID],
+		}
+		cache.stellarChainsBySelector[details.ChainSelector] = chain
 	}
 
-	return cache, nil
+	return cache
 }
 
 // applyOptions applies functional options and returns a config with defaults
@@ -268,123 +442,40 @@ type ChainDetailsWithMetadata struct {
 
 // GetChainDetailsBySelector fetches chain data and returns chain details for a given selector.
 // It first checks local embedded data, then falls back to remote if not found locally.
-func GetChainDetailsBySelector(ctx context.Context, selector uint64, opts ...Option) (ChainDetailsWithMetadata, error) {
-	config := applyOptions(opts)
-
+func (c *Client) GetChainDetailsBySelector(ctx context.Context, selector uint64) (ChainDetailsWithMetadata, error) {
 	// Try local data first
 	if localResult, err := getChainDetailsBySelectorFromLocal(selector); err == nil {
 		return localResult, nil
 	}
 	// If not found locally, try remote
 
-	cache, err := fetchRemoteSelectors(ctx, config)
+	cache, err := c.fetchRemoteSelectors(ctx)
 	if err != nil {
 		return ChainDetailsWithMetadata{}, err
 	}
 
-	// Check EVM chains
-	for chainID, details := range cache.evmChainIdToChainSelector {
-		if details.ChainSelector == selector {
-			return ChainDetailsWithMetadata{
-				ChainDetails: details,
-				Family:       chain_selectors.FamilyEVM,
-				ChainID:      fmt.Sprintf("%d", chainID),
-			}, nil
-		}
-	}
-
-	// Check Solana chains
-	for chainID, details := range cache.solanaChainIdToChainSelector {
-		if details.ChainSelector == selector {
-			return ChainDetailsWithMetadata{
-				ChainDetails: details,
-				Family:       chain_selectors.FamilySolana,
-				ChainID:      chainID,
-			}, nil
-		}
-	}
-
-	// Check Aptos chains
-	for chainID, details := range cache.aptosSelectorsMap {
-		if details.ChainSelector == selector {
-			return ChainDetailsWithMetadata{
-				ChainDetails: details,
-				Family:       chain_selectors.FamilyAptos,
-				ChainID:      fmt.Sprintf("%d", chainID),
-			}, nil
-		}
-	}
-
-	// Check Sui chains
-	for chainID, details := range cache.suiSelectorsMap {
-		if details.ChainSelector == selector {
-			return ChainDetailsWithMetadata{
-				ChainDetails: details,
-				Family:       chain_selectors.FamilySui,
-				ChainID:      fmt.Sprintf("%d", chainID),
-			}, nil
-		}
-	}
-
-	// Check Tron chains
-	for chainID, details := range cache.tronSelectorsMap {
-		if details.ChainSelector == selector {
-			return ChainDetailsWithMetadata{
-				ChainDetails: details,
-				Family:       chain_selectors.FamilyTron,
-				ChainID:      fmt.Sprintf("%d", chainID),
-			}, nil
-		}
-	}
-
-	// Check Ton chains
-	for chainID, details := range cache.tonSelectorsMap {
-		if details.ChainSelector == selector {
-			return ChainDetailsWithMetadata{
-				ChainDetails: details,
-				Family:       chain_selectors.FamilyTon,
-				ChainID:      fmt.Sprintf("%d", chainID),
-			}, nil
-		}
-	}
-
-	// Check Starknet chains
-	for chainID, details := range cache.starknetSelectorsMap {
-		if details.ChainSelector == selector {
-			return ChainDetailsWithMetadata{
-				ChainDetails: details,
-				Family:       chain_selectors.FamilyStarknet,
-				ChainID:      chainID,
-			}, nil
-		}
+	if chain, exists := cache.chainsBySelector[selector]; exists {
+		return chain, nil
 	}
 
-	// Check Canton chains
-	for chainID, details := range cache.cantonSelectorsMap {
-		if details.ChainSelector == selector {
-			return ChainDetailsWithMetadata{
-				ChainDetails: details,
-				Family:       chain_selectors.FamilyCanton,
-				ChainID:      chainID,
-			}, nil
-		}
-	}
+	return ChainDetailsWithMetadata{}, newLookupError(chain_selectors.ErrUnknownSelector, nil, "unknown chain selector %d", selector)
+}
 
-	return ChainDetailsWithMetadata{}, fmt.Errorf("unknown chain selector %d", selector)
+// GetChainDetailsBySelector calls Client.GetChainDetailsBySelector on the package level client for the given options.
+func GetChainDetailsBySelector(ctx context.Context, selector uint64, opts ...Option) (ChainDetailsWithMetadata, error) {
+	return clientFor(opts).GetChainDetailsBySelector(ctx, selector)
 }
 
 // GetChainDetailsByChainIDAndFamily fetches chain data and returns chain details for a given chain ID and family.
 // It first checks local embedded data, then falls back to remote if not found locally.
-func GetChainDetailsByChainIDAndFamily(ctx context.Context, chainID string, family string, opts ...Option) (chain_selectors.ChainDetails, error) {
-	config := applyOptions(opts)
-
+func (c *Client) GetChainDetailsByChainIDAndFamily(ctx context.Context, chainID string, family string) (chain_selectors.ChainDetails, error) {
 	// Try local data first
 	if localResult, err := chain_selectors.GetChainDetailsByChainIDAndFamily(chainID, family); err == nil {
 		return localResult, nil
 	}
 	// If not found locally, try remote
 
-	cache, err := fetchRemoteSelectors(ctx, config)
+	cache, err := c.fetchRemoteSelectors(ctx)
 	if err != nil {
 		return chain_selectors.ChainDetails{}, err
 	}
@@ -393,14 +484,14 @@ func GetChainDetailsByChainIDAndFamily(ctx context.Context, chainID string, fami
 		if details, exist := checkExists(); exist {
 			return details, nil
 		}
-		return chain_selectors.ChainDetails{}, fmt.Errorf("invalid chain id %s for %s", chainID, family)
+		return chain_selectors.ChainDetails{}, newLookupError(chain_selectors.ErrUnknownChainID{Family: family, ChainID: chainID}, nil, "invalid chain id %s for %s", chainID, family)
 	}
 
 	switch family {
 	case chain_selectors.FamilyEVM:
 		evmChainID, err := strconv.ParseUint(chainID, 10, 64)
 		if err != nil {
-			return chain_selectors.ChainDetails{}, fmt.Errorf("invalid chain id %s for %s", chainID, family)
+			return chain_selectors.ChainDetails{}, newLookupError(chain_selectors.ErrInvalidChainID, err, "invalid chain id %s for %s", chainID, family)
 		}
 		return tryRemote(func() (chain_selectors.ChainDetails, bool) {
 			details, exist := cache.evmChainIdToChainSelector[evmChainID]
@@ -416,7 +507,7 @@ func GetChainDetailsByChainIDAndFamily(ctx context.Context, chainID string, fami
 	case chain_selectors.FamilyAptos:
 		aptosChainID, err := strconv.ParseUint(chainID, 10, 64)
 		if err != nil {
-			return chain_selectors.ChainDetails{}, fmt.Errorf("invalid chain id %s for %s", chainID, family)
+			return chain_selectors.ChainDetails{}, newLookupError(chain_selectors.ErrInvalidChainID, err, "invalid chain id %s for %s", chainID, family)
 		}
 		return tryRemote(func() (chain_selectors.ChainDetails, bool) {
 			details, exist := cache.aptosSelectorsMap[aptosChainID]
@@ -426,7 +517,7 @@ func GetChainDetailsByChainIDAndFamily(ctx context.Context, chainID string, fami
 	case chain_selectors.FamilySui:
 		suiChainID, err := strconv.ParseUint(chainID, 10, 64)
 		if err != nil {
-			return chain_selectors.ChainDetails{}, fmt.Errorf("invalid chain id %s for %s", chainID, family)
+			return chain_selectors.ChainDetails{}, newLookupError(chain_selectors.ErrInvalidChainID, err, "invalid chain id %s for %s", chainID, family)
 		}
 		return tryRemote(func() (chain_selectors.ChainDetails, bool) {
 			details, exist := cache.suiSelectorsMap[suiChainID]
@@ -436,7 +527,7 @@ func GetChainDetailsByChainIDAndFamily(ctx context.Context, chainID string, fami
 	case chain_selectors.FamilyTron:
 		tronChainID, err := strconv.ParseUint(chainID, 10, 64)
 		if err != nil {
-			return chain_selectors.ChainDetails{}, fmt.Errorf("invalid chain id %s for %s", chainID, family)
+			return chain_selectors.ChainDetails{}, newLookupError(chain_selectors.ErrInvalidChainID, err, "invalid chain id %s for %s", chainID, family)
 		}
 		return tryRemote(func() (chain_selectors.ChainDetails, bool) {
 			details, exist := cache.tronSelectorsMap[tronChainID]
@@ -446,7 +537,7 @@ func GetChainDetailsByChainIDAndFamily(ctx context.Context, chainID string, fami
 	case chain_selectors.FamilyTon:
 		tonChainID, err := strconv.ParseInt(chainID, 10, 32)
 		if err != nil {
-			return chain_selectors.ChainDetails{}, fmt.Errorf("invalid chain id %s for %s", chainID, family)
+			return chain_selectors.ChainDetails{}, newLookupError(chain_selectors.ErrInvalidChainID, err, "invalid chain id %s for %s", chainID, family)
 		}
 		return tryRemote(func() (chain_selectors.ChainDetails, bool) {
 			details, exist := cache.tonSelectorsMap[int32(tonChainID)]
@@ -465,14 +556,31 @@ func GetChainDetailsByChainIDAndFamily(ctx context.Context, chainID string, fami
 			return details, exist
 		})
 
+	case chain_selectors.FamilyCosmos:
+		return tryRemote(func() (chain_selectors.ChainDetails, bool) {
+			details, exist := cache.cosmosSelectorsMap[chainID]
+			return details, exist
+		})
+
+	case chain_selectors.FamilyStellar:
+		return tryRemote(func() (chain_selectors.ChainDetails, bool) {
+			details, exist := cache.stellarSelectorsMap[chainID]
+			return details, exist
+		})
+
 	default:
-		return chain_selectors.ChainDetails{}, fmt.Errorf("family %s is not supported", family)
+		return chain_selectors.ChainDetails{}, newLookupError(chain_selectors.ErrUnsupportedFamily, nil, "family %s is not supported", family)
 	}
 }
 
+// GetChainDetailsByChainIDAndFamily calls Client.GetChainDetailsByChainIDAndFamily on the package level client for the given options.
+func GetChainDetailsByChainIDAndFamily(ctx context.Context, chainID string, family string, opts ...Option) (chain_selectors.ChainDetails, error) {
+	return clientFor(opts).GetChainDetailsByChainIDAndFamily(ctx, chainID, family)
+}
+
 // IsDeprecated reports whether the chain for the given selector has been sunset or superseded
-func IsDeprecated(ctx context.Context, selector uint64, opts ...Option) (bool, error) {
-	details, err := GetChainDetailsBySelector(ctx, selector, opts...)
+func (c *Client) IsDeprecated(ctx context.Context, selector uint64) (bool, error) {
+	details, err := c.GetChainDetailsBySelector(ctx, selector)
 	if err != nil {
 		return false, err
 	}
@@ -480,11 +588,9 @@ func IsDeprecated(ctx context.Context, selector uint64, opts ...Option) (bool, e
 	return details.Deprecated, nil
 }
 
-// ClearCache clears the remote data cache, forcing the next remote call to fetch fresh data
-func ClearCache() {
-	remoteCacheLock.Lock()
-	remoteCache = nil
-	remoteCacheLock.Unlock()
+// IsDeprecated calls Client.IsDeprecated on the package level client for the given options.
+func IsDeprecated(ctx context.Context, selector uint64, opts ...Option) (bool, error) {
+	return clientFor(opts).IsDeprecated(ctx, selector)
 }
 
 // getChainDetailsBySelectorFromLocal is a helper to get chain details from local embedded data
@@ -36,49 +36,51 @@ evm:
     selector: 4949039107694359620
     name: arbitrum-mainnet
 solana:
-  "mainnet":
+  "5eykt4UsFv8P8NJdTREpY1vzqKqZKvdpKuc147dw2N9d":
     selector: 124615329519749607
     name: solana-mainnet
-  "testnet":
-    selector: 1666700230607807939
+  "4uhcVJyU9pJkvQyS88uRDiswHXSCkY3zQawwpjk2NsNY":
+    selector: 6302590918974934319
     name: solana-testnet
-  "devnet":
-    selector: 7633325390517157182
+  "EtWTRABZaYq6iMfeYKouRu166VU2xqa1wcaWoxPkrZBG":
+    selector: 16423721717087811551
     name: solana-devnet
 aptos:
   1:
-    selector: 5880489174233984516
+    selector: 4741433654826277614
     name: aptos-mainnet
+    network_type: mainnet
   2:
-    selector: 6433500567565415381
+    selector: 743186221051783445
     name: aptos-testnet
+    network_type: testnet
 sui:
   1:
-    selector: 5790810961207155433
+    selector: 17529533435026248318
     name: sui-mainnet
   2:
-    selector: 4419140975832851138
+    selector: 9762610643973837292
     name: sui-testnet
 ton:
   -239:
-    selector: 5264266016034146460
+    selector: 16448340667252469081
     name: ton-mainnet
   -3:
-    selector: 3989674961303603008
+    selector: 1399300952838017768
     name: ton-testnet
 tron:
   728126428:
-    selector: 10902061574536243337
+    selector: 1546563616611573945
     name: tron-mainnet
   2494104990:
-    selector: 8482416459910711315
+    selector: 13231703482326770597
     name: tron-testnet-shasta
 starknet:
   "SN_MAIN":
-    selector: 3919063707296401440
+    selector: 511843109281680063
     name: ethereum-mainnet-starknet-1
   "SN_SEPOLIA":
-    selector: 1924942427828825923
+    selector: 4115550741429562104
     name: ethereum-testnet-sepolia-starknet-1
 `
 
@@ -142,13 +144,13 @@ func TestGetChainDetailsByChainIDAndFamily(t *testing.T) {
 	assert.Error(t, err)
 
 	// Test with Solana chain
-	details, err = GetChainDetailsByChainIDAndFamily(ctx, "mainnet", chain_selectors.FamilySolana,
+	details, err = GetChainDetailsByChainIDAndFamily(ctx, "EtWTRABZaYq6iMfeYKouRu166VU2xqa1wcaWoxPkrZBG", chain_selectors.FamilySolana,
 		WithURL(server.URL),
 		WithTimeout(5*time.Second),
 	)
 	require.NoError(t, err)
-	assert.Equal(t, uint64(124615329519749607), details.ChainSelector)
-	assert.Equal(t, "solana-mainnet", details.ChainName)
+	assert.Equal(t, uint64(16423721717087811551), details.ChainSelector)
+	assert.Equal(t, "solana-devnet", details.ChainName)
 }
 
 func TestIsDeprecated(t *testing.T) {
@@ -179,6 +181,36 @@ func TestIsDeprecated(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func TestGetCosmosChainDetails(t *testing.T) {
+	ClearCache()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`
+cosmos:
+  juno-1:
+    selector: 6661234567890123456
+    name: juno-mainnet
+    network_type: mainnet
+`))
+	}))
+	t.Cleanup(server.Close)
+
+	ctx := context.Background()
+
+	details, err := GetChainDetailsBySelector(ctx, 6661234567890123456, WithURL(server.URL))
+	require.NoError(t, err)
+	assert.Equal(t, chain_selectors.FamilyCosmos, details.Family)
+	assert.Equal(t, "juno-1", details.ChainID)
+	assert.Equal(t, "juno-mainnet", details.ChainName)
+
+	chainDetails, err := GetChainDetailsByChainIDAndFamily(ctx, "juno-1", chain_selectors.FamilyCosmos, WithURL(server.URL))
+	require.NoError(t, err)
+	assert.Equal(t, uint64(6661234567890123456), chainDetails.ChainSelector)
+
+	_, err = GetChainDetailsByChainIDAndFamily(ctx, "unknown-1", chain_selectors.FamilyCosmos, WithURL(server.URL))
+	assert.Error(t, err)
+}
+
 func TestRemoteWithMockServer(t *testing.T) {
 	ClearCache()
 	// Create a mock server that returns test data
@@ -195,14 +227,15 @@ solana:
     name: solana-mainnet
 aptos:
   1:
-    selector: 4741433654867091981
+    selector: 4741433654826277614
     name: aptos-mainnet
+    network_type: mainnet
 canton:
   MainNet:
-    selector: 2199546568103630433
+    selector: 2308837218439511688
     name: canton-mainnet
   TestNet:
-    selector: 13503176106905080262
+    selector: 9268731218649498074
     name: canton-testnet
 `
 
@@ -253,7 +286,7 @@ canton:
 
 	// Test with Canton chain
 	t.Run("GetCantonChainDetails", func(t *testing.T) {
-		details, err := GetChainDetailsBySelector(ctx, 2199546568103630433,
+		details, err := GetChainDetailsBySelector(ctx, 2308837218439511688,
 			WithURL(server.URL),
 		)
 		require.NoError(t, err)
@@ -381,7 +414,7 @@ evm:
     selector: 5009297550715157269
     name: ethereum-mainnet
 solana:
-  "mainnet":
+  "5eykt4UsFv8P8NJdTREpY1vzqKqZKvdpKuc147dw2N9d":
     selector: 124615329519749607
     name: solana-mainnet
 `
@@ -413,7 +446,7 @@ solana:
 		// Create a mock server with a chain that exists only in remote, not in local
 		remoteMockYAML := `
 evm:
-  999999999:
+  4242424242:
     selector: 8888888888888888888
     name: test-remote-only-chain
     network_type: testnet
@@ -489,3 +522,52 @@ evm:
 		assert.Contains(t, details.ChainName, "devnet")
 	})
 }
+
+func TestRemoteChainsKeepNetworkTypeAndDeprecation(t *testing.T) {
+	cache, err := parseRemoteSelectors([]byte(`
+evm:
+  4242424242:
+    selector: 1111111111111111111
+    name: test-remote-evm
+    network_type: testnet
+    deprecated: true
+solana:
+  ASwXBTzJM5evpfrWSHSjZaxPErZRuiGJnFixGUHi4NQT:
+    selector: 2222222222222222222
+    name: test-remote-solana
+    network_type: testnet
+    deprecated: true
+aptos:
+  42:
+    selector: 3333333333333333333
+    name: test-remote-aptos
+    network_type: testnet
+    deprecated: true
+sui:
+  42:
+    selector: 4444444444444444444
+    name: test-remote-sui
+    network_type: testnet
+    deprecated: true
+starknet:
+  TEST_REMOTE:
+    selector: 5555555555555555555
+    name: test-remote-starknet
+    network_type: testnet
+    deprecated: true
+`))
+	require.NoError(t, err)
+
+	chains := []chain_selectors.ChainInfo{
+		cache.evmChainsBySelector[1111111111111111111],
+		cache.evmChainsByEvmChainID[4242424242],
+		cache.solanaChainsBySelector[2222222222222222222],
+		cache.aptosChainsBySelector[3333333333333333333],
+		cache.suiChainsBySelector[4444444444444444444],
+		cache.starknetChainsBySelector[5555555555555555555],
+	}
+	for _, chain := range chains {
+		assert.Equal(t, chain_selectors.NetworkTypeTestnet, chain.GetNetworkType(), chain.GetName())
+		assert.True(t, chain.IsDeprecated(), chain.GetName())
+	}
+}
@@ -0,0 +1,56 @@
+package remote
+
+import (
+	"context"
+
+	chain_selectors "github.com/smartcontractkit/chain-selectors"
+)
+
+// StellarPassphraseFromChainId returns the network passphrase for a Stellar chain ID (network ID).
+// It first checks local embedded data, then falls back to remote if not found.
+func (c *Client) StellarPassphraseFromChainId(ctx context.Context, chainID string) (string, error) {
+	// Try local data first
+	if passphrase, err := chain_selectors.StellarPassphraseFromChainId(chainID); err == nil {
+		return passphrase, nil
+	}
+	// If not found locally, try remote
+
+	cache, err := c.fetchRemoteSelectors(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	passphrase, exist := cache.stellarChainIdToPassphrase[chainID]
+	if !exist || passphrase == "" {
+		return "", newLookupError(chain_selectors.ErrUnknownChainID{Family: chain_selectors.FamilyStellar, ChainID: chainID}, nil, "network passphrase not found for chain: %v", chainID)
+	}
+	return passphrase, nil
+}
+
+// StellarPassphraseFromChainId calls Client.StellarPassphraseFromChainId on the package level client for the given options.
+func StellarPassphraseFromChainId(ctx context.Context, chainID string, opts ...Option) (string, error) {
+	return clientFor(opts).StellarPassphraseFromChainId(ctx, chainID)
+}
+
+// StellarChainBySelector returns the Stellar chain for a given selector.
+// It first checks local embedded data, then falls back to remote if not found.
+func (c *Client) StellarChainBySelector(ctx context.Context, selector uint64) (chain_selectors.StellarChain, bool, error) {
+	// Try local data first
+	if ch, exists := chain_selectors.StellarChainBySelector(selector); exists {
+		return ch, true, nil
+	}
+	// If not found locally, try remote
+
+	cache, err := c.fetchRemoteSelectors(ctx)
+	if err != nil {
+		return chain_selectors.StellarChain{}, false, err
+	}
+
+	ch, exists := cache.stellarChainsBySelector[selector]
+	return ch, exists, nil
+}
+
+// StellarChainBySelector calls Client.StellarChainBySelector on the package level client for the given options.
+func StellarChainBySelector(ctx context.Context, selector uint64, opts ...Option) (chain_selectors.StellarChain, bool, error) {
+	return clientFor(opts).StellarChainBySelector(ctx, selector)
+}
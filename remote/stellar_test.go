@@ -0,0 +1,82 @@
+package remote
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	chain_selectors "github.com/smartcontractkit/chain-selectors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const stellarMockYAML = `
+stellar:
+  a3a1c6a78286713e29be0e9785670fa838d13917cd8eaeb4a3579ff1debc7fd5:
+    selector: 5555555555555555555
+    name: stellar-testnet-futurenet
+    network_type: testnet
+    passphrase: "Test SDF Future Network ; October 2022"
+`
+
+const futurenetChainID = "a3a1c6a78286713e29be0e9785670fa838d13917cd8eaeb4a3579ff1debc7fd5"
+
+func newStellarMockServer() *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(stellarMockYAML))
+	}))
+}
+
+func TestStellarPassphraseFromChainId(t *testing.T) {
+	ClearCache()
+	server := newStellarMockServer()
+	t.Cleanup(server.Close)
+
+	ctx := context.Background()
+
+	t.Run("remote only network", func(t *testing.T) {
+		passphrase, err := StellarPassphraseFromChainId(ctx, futurenetChainID, WithURL(server.URL))
+		require.NoError(t, err)
+		assert.Equal(t, "Test SDF Future Network ; October 2022", passphrase)
+	})
+
+	t.Run("embedded network", func(t *testing.T) {
+		passphrase, err := StellarPassphraseFromChainId(ctx, chain_selectors.STELLAR_MAINNET.ChainID, WithURL(server.URL))
+		require.NoError(t, err)
+		assert.Equal(t, chain_selectors.STELLAR_MAINNET.Passphrase, passphrase)
+	})
+
+	t.Run("unknown network", func(t *testing.T) {
+		_, err := StellarPassphraseFromChainId(ctx, "unknown", WithURL(server.URL))
+		assert.ErrorContains(t, err, "network passphrase not found for chain: unknown")
+	})
+}
+
+func TestStellarChainDetails(t *testing.T) {
+	ClearCache()
+	server := newStellarMockServer()
+	t.Cleanup(server.Close)
+
+	ctx := context.Background()
+
+	details, err := GetChainDetailsBySelector(ctx, 5555555555555555555, WithURL(server.URL))
+	require.NoError(t, err)
+	assert.Equal(t, chain_selectors.FamilyStellar, details.Family)
+	assert.Equal(t, futurenetChainID, details.ChainID)
+	assert.Equal(t, "stellar-testnet-futurenet", details.ChainName)
+
+	chainDetails, err := GetChainDetailsByChainIDAndFamily(ctx, futurenetChainID, chain_selectors.FamilyStellar, WithURL(server.URL))
+	require.NoError(t, err)
+	assert.Equal(t, uint64(5555555555555555555), chainDetails.ChainSelector)
+
+	chain, exists, err := StellarChainBySelector(ctx, 5555555555555555555, WithURL(server.URL))
+	require.NoError(t, err)
+	require.True(t, exists)
+	assert.Equal(t, "Test SDF Future Network ; October 2022", chain.Passphrase)
+	assert.Equal(t, chain_selectors.NetworkTypeTestnet, chain.NetworkType)
+
+	_, err = GetChainDetailsByChainIDAndFamily(ctx, "unknown", chain_selectors.FamilyStellar, WithURL(server.URL))
+	assert.Error(t, err)
+}
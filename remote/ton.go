@@ -0,0 +1,30 @@
+package remote
+
+import (
+	"context"
+
+	chain_selectors "github.com/smartcontractkit/chain-selectors"
+)
+
+// TonChainBySelector returns the Ton chain for a given selector.
+// It first checks local embedded data, then falls back to remote if not found.
+func (c *Client) TonChainBySelector(ctx context.Context, selector uint64) (chain_selectors.TonChain, bool, error) {
+	// Try local data first
+	if ch, exists := chain_selectors.TonChainBySelector(selector); exists {
+		return ch, true, nil
+	}
+	// If not found locally, try remote
+
+	cache, err := c.fetchRemoteSelectors(ctx)
+	if err != nil {
+		return chain_selectors.TonChain{}, false, err
+	}
+
+	ch, exists := cache.tonChainsBySelector[selector]
+	return ch, exists, nil
+}
+
+// TonChainBySelector calls Client.TonChainBySelector on the package level client for the given options.
+func TonChainBySelector(ctx context.Context, selector uint64, opts ...Option) (chain_selectors.TonChain, bool, error) {
+	return clientFor(opts).TonChainBySelector(ctx, selector)
+}
@@ -0,0 +1,55 @@
+package remote
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	chain_selectors "github.com/smartcontractkit/chain-selectors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTonChainBySelector(t *testing.T) {
+	ClearCache()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`
+ton:
+  -42:
+    selector: 4444444444444444444
+    name: ton-testnet-remote
+    network_type: testnet
+`))
+	}))
+	t.Cleanup(server.Close)
+
+	ctx := context.Background()
+
+	t.Run("remote only chain", func(t *testing.T) {
+		chain, exists, err := TonChainBySelector(ctx, 4444444444444444444, WithURL(server.URL))
+		require.NoError(t, err)
+		require.True(t, exists)
+		assert.Equal(t, chain_selectors.TonChain{ChainID: int32(-42), Selector: 4444444444444444444, Name: "ton-testnet-remote", NetworkType: chain_selectors.NetworkTypeTestnet}, chain)
+	})
+
+	t.Run("embedded chain", func(t *testing.T) {
+		chain, exists, err := NewClient(WithURL(server.URL)).TonChainBySelector(ctx, chain_selectors.TON_MAINNET.Selector)
+		require.NoError(t, err)
+		require.True(t, exists)
+		assert.Equal(t, chain_selectors.TON_MAINNET, chain)
+	})
+
+	t.Run("unknown chain", func(t *testing.T) {
+		_, exists, err := TonChainBySelector(ctx, 1, WithURL(server.URL))
+		require.NoError(t, err)
+		assert.False(t, exists)
+	})
+
+	t.Run("chain of another family", func(t *testing.T) {
+		_, exists, err := TonChainBySelector(ctx, chain_selectors.ETHEREUM_MAINNET.Selector, WithURL(server.URL))
+		require.NoError(t, err)
+		assert.False(t, exists)
+	})
+}
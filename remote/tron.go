@@ -0,0 +1,30 @@
+package remote
+
+import (
+	"context"
+
+	chain_selectors "github.com/smartcontractkit/chain-selectors"
+)
+
+// TronChainBySelector returns the Tron chain for a given selector.
+// It first checks local embedded data, then falls back to remote if not found.
+func (c *Client) TronChainBySelector(ctx context.Context, selector uint64) (chain_selectors.TronChain, bool, error) {
+	// Try local data first
+	if ch, exists := chain_selectors.TronChainBySelector(selector); exists {
+		return ch, true, nil
+	}
+	// If not found locally, try remote
+
+	cache, err := c.fetchRemoteSelectors(ctx)
+	if err != nil {
+		return chain_selectors.TronChain{}, false, err
+	}
+
+	ch, exists := cache.tronChainsBySelector[selector]
+	return ch, exists, nil
+}
+
+// TronChainBySelector calls Client.TronChainBySelector on the package level client for the given options.
+func TronChainBySelector(ctx context.Context, selector uint64, opts ...Option) (chain_selectors.TronChain, bool, error) {
+	return clientFor(opts).TronChainBySelector(ctx, selector)
+}
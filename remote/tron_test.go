@@ -0,0 +1,55 @@
+package remote
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	chain_selectors "github.com/smartcontractkit/chain-selectors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTronChainBySelector(t *testing.T) {
+	ClearCache()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`
+tron:
+  4242:
+    selector: 3333333333333333333
+    name: tron-testnet-remote
+    network_type: testnet
+`))
+	}))
+	t.Cleanup(server.Close)
+
+	ctx := context.Background()
+
+	t.Run("remote only chain", func(t *testing.T) {
+		chain, exists, err := TronChainBySelector(ctx, 3333333333333333333, WithURL(server.URL))
+		require.NoError(t, err)
+		require.True(t, exists)
+		assert.Equal(t, chain_selectors.TronChain{ChainID: uint64(4242), Selector: 3333333333333333333, Name: "tron-testnet-remote", NetworkType: chain_selectors.NetworkTypeTestnet}, chain)
+	})
+
+	t.Run("embedded chain", func(t *testing.T) {
+		chain, exists, err := NewClient(WithURL(server.URL)).TronChainBySelector(ctx, chain_selectors.TRON_MAINNET.Selector)
+		require.NoError(t, err)
+		require.True(t, exists)
+		assert.Equal(t, chain_selectors.TRON_MAINNET, chain)
+	})
+
+	t.Run("unknown chain", func(t *testing.T) {
+		_, exists, err := TronChainBySelector(ctx, 1, WithURL(server.URL))
+		require.NoError(t, err)
+		assert.False(t, exists)
+	})
+
+	t.Run("chain of another family", func(t *testing.T) {
+		_, exists, err := TronChainBySelector(ctx, chain_selectors.ETHEREUM_MAINNET.Selector, WithURL(server.URL))
+		require.NoError(t, err)
+		assert.False(t, exists)
+	})
+}
@@ -0,0 +1,211 @@
+package chain_selectors
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+const (
+	// minSearchScore is the score below which chain names are not considered a match
+	minSearchScore = 0.55
+	// maxSuggestions is the number of names suggested by failed name lookups
+	maxSuggestions = 3
+)
+
+// ChainNameMatch is a chain whose name matches a search, see SearchChainNames.
+type ChainNameMatch struct {
+	Name     string
+	Family   string
+	Selector uint64
+	// Score ranks the match from 0 to 1, which is an exact match.
+	Score float64
+}
+
+// SearchChainNames returns the chains of the default registry whose name is close to query, see Registry.SearchChainNames.
+func SearchChainNames(query string, limit int) []ChainNameMatch {
+	return defaultRegistry.SearchChainNames(query, limit)
+}
+
+// SearchChainNames returns up to limit chains whose name is close to query, best matches first.
+// A limit <= 0 returns every match.
+//
+// Names are split into tokens on "-" and "_", following the <blockchain>-<type>-<network_instance>
+// naming convention, and ranked by their edit distance to query and by how many tokens they share with it,
+// so that typos as well as missing or reordered parts are matched, e.g. "ethereum-sepolia" matches
+// "ethereum-testnet-sepolia".
+func (r *Registry) SearchChainNames(query string, limit int) []ChainNameMatch {
+	return r.snapshot().searchChainNames(query, limit, func(chainEntry) bool { return true })
+}
+
+func (s *registrySnapshot) searchChainNames(query string, limit int, filter func(chainEntry) bool) []ChainNameMatch {
+	query = strings.ToLower(strings.TrimSpace(query))
+	if query == "" {
+		return nil
+	}
+	queryTokens := nameTokens(query)
+
+	var matches []ChainNameMatch
+	for name, selector := range s.byName {
+		e := s.bySelector[selector]
+		if !filter(e) {
+			continue
+		}
+		score := nameScore(query, queryTokens, name)
+		if score < minSearchScore {
+			continue
+		}
+		matches = append(matches, ChainNameMatch{Name: name, Family: e.Family, Selector: selector, Score: score})
+	}
+
+	sort.Slice(matches, func(i, j int) bool {
+		if matches[i].Score != matches[j].Score {
+			return matches[i].Score > matches[j].Score
+		}
+		return matches[i].Name < matches[j].Name
+	})
+	if limit > 0 && len(matches) > limit {
+		matches = matches[:limit]
+	}
+	return matches
+}
+
+// unknownNameError is returned by failed name lookups. The names close to the looked up one are only searched
+// when the message is formatted, so that callers checking errors.Is or falling back to other data don't pay for it.
+type unknownNameError struct {
+	message  string
+	name     string
+	snapshot *registrySnapshot
+	filter   func(chainEntry) bool
+
+	once      sync.Once
+	formatted string
+}
+
+// newUnknownNameError returns an error wrapping ErrUnknownChainName with the formatted message, followed by
+// the names of s matching filter that are close to name.
+func newUnknownNameError(s *registrySnapshot, name string, filter func(chainEntry) bool, format string, args ...any) error {
+	return &unknownNameError{message: fmt.Sprintf(format, args...), name: name, snapshot: s, filter: filter}
+}
+
+func (e *unknownNameError) Error() string {
+	e.once.Do(func() {
+		e.formatted = e.message + e.snapshot.suggestNames(e.name, e.filter)
+	})
+	return e.formatted
+}
+
+func (e *unknownNameError) Unwrap() error {
+	return ErrUnknownChainName
+}
+
+// suggestNames returns the text appended to the error of a failed lookup of name, if any chain name is close to it.
+func (s *registrySnapshot) suggestNames(name string, filter func(chainEntry) bool) string {
+	matches := s.searchChainNames(name, maxSuggestions, filter)
+	if len(matches) == 0 {
+		return ""
+	}
+	names := make([]string, 0, len(matches))
+	for _, m := range matches {
+		names = append(names, m.Name)
+	}
+	if len(names) == 1 {
+		return ", did you mean " + names[0] + "?"
+	}
+	return ", did you mean " + strings.Join(names[:len(names)-1], ", ") + " or " + names[len(names)-1] + "?"
+}
+
+// nameScore ranks name against query. Names sharing most of their tokens with query rank high even if the
+// edit distance is large, e.g. "arbitrum" and "ethereum-mainnet-arbitrum-1".
+func nameScore(query string, queryTokens []string, name string) float64 {
+	name = strings.ToLower(name)
+	if query == name {
+		return 1
+	}
+	overlap := tokenOverlap(queryTokens, nameTokens(name))
+	return max(overlap, (similarity(query, name)+overlap)/2)
+}
+
+// nameTokens splits a chain name into the words of its components.
+func nameTokens(name string) []string {
+	return strings.FieldsFunc(name, func(r rune) bool { return r == '-' || r == '_' })
+}
+
+// tokenWeight returns how much a token identifies a chain. Network types and instance numbers are shared by
+// many chains, so they weigh less than blockchain and network names.
+func tokenWeight(token string) float64 {
+	switch {
+	case token == "mainnet" || token == "testnet" || token == "devnet":
+		return 0.25
+	case strings.Trim(token, "0123456789") == "":
+		return 0.5
+	default:
+		return 1
+	}
+}
+
+// tokenOverlap returns how much two token lists have in common, from 0 to 1, averaging the weighted share of
+// the tokens of a found in b and of b found in a. Tokens match when they are equal or differ by a typo,
+// regardless of their position.
+func tokenOverlap(a, b []string) float64 {
+	var weightA, weightB, matched float64
+	for _, tb := range b {
+		weightB += tokenWeight(tb)
+	}
+	used := make([]bool, len(b))
+	for _, ta := range a {
+		weightA += tokenWeight(ta)
+		best, bestIndex := 0.0, -1
+		for i, tb := range b {
+			if used[i] {
+				continue
+			}
+			if sim := similarity(ta, tb); sim > best {
+				best, bestIndex = sim, i
+			}
+		}
+		// Tokens that are not at least mostly alike don't count, e.g. "mainnet" and "testnet"
+		if bestIndex >= 0 && best >= 0.75 {
+			used[bestIndex] = true
+			matched += best * min(tokenWeight(ta), tokenWeight(b[bestIndex]))
+		}
+	}
+	if weightA == 0 || weightB == 0 {
+		return 0
+	}
+	return (matched/weightA + matched/weightB) / 2
+}
+
+// similarity returns 1 minus the edit distance of a and b relative to the longest of them.
+func similarity(a, b string) float64 {
+	longest := len(a)
+	if len(b) > longest {
+		longest = len(b)
+	}
+	if longest == 0 {
+		return 1
+	}
+	return 1 - float64(editDistance(a, b))/float64(longest)
+}
+
+// editDistance returns the Levenshtein distance between a and b.
+func editDistance(a, b string) int {
+	previous := make([]int, len(b)+1)
+	current := make([]int, len(b)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		current[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+	return previous[len(b)]
+}
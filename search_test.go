@@ -0,0 +1,76 @@
+package chain_selectors
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSearchChainNames(t *testing.T) {
+	tests := []struct {
+		query    string
+		expected string
+	}{
+		{query: "ethereum-testnet-sepolia-arbitrum1", expected: "ethereum-testnet-sepolia-arbitrum-1"},
+		{query: "etherum-mainnet", expected: "ethereum-mainnet"},
+		{query: "ethereum-sepolia", expected: "ethereum-testnet-sepolia"},
+		{query: "Ethereum-Mainnet", expected: "ethereum-mainnet"},
+		{query: "arbitrum", expected: "ethereum-mainnet-arbitrum-1"},
+		{query: "solana-main", expected: "solana-mainnet"},
+	}
+	for _, test := range tests {
+		t.Run(test.query, func(t *testing.T) {
+			matches := SearchChainNames(test.query, 3)
+			require.NotEmpty(t, matches)
+			assert.LessOrEqual(t, len(matches), 3)
+			assert.Equal(t, test.expected, matches[0].Name)
+
+			details, err := GetChainDetailsByNetworkName(matches[0].Name)
+			require.NoError(t, err)
+			assert.Equal(t, details.ChainSelector, matches[0].Selector)
+			for i := 1; i < len(matches); i++ {
+				assert.GreaterOrEqual(t, matches[i-1].Score, matches[i].Score)
+			}
+		})
+	}
+
+	exact := SearchChainNames("ethereum-mainnet", 0)
+	require.NotEmpty(t, exact)
+	assert.Equal(t, ChainNameMatch{Name: "ethereum-mainnet", Family: FamilyEVM, Selector: ETHEREUM_MAINNET.Selector, Score: 1}, exact[0])
+
+	assert.Empty(t, SearchChainNames("xyz", 0))
+	assert.Empty(t, SearchChainNames("", 0))
+}
+
+func TestNameLookupSuggestions(t *testing.T) {
+	_, err := GetChainDetailsByNetworkName("ethereum-testnet-sepolia-arbitrum1")
+	assert.ErrorIs(t, err, ErrUnknownChainName)
+	assert.ErrorContains(t, err, "chain details not found for network name ethereum-testnet-sepolia-arbitrum1, did you mean ethereum-testnet-sepolia-arbitrum-1,")
+
+	_, err = GetChainDetailsByNetworkName("xyz")
+	assert.EqualError(t, err, "chain details not found for network name xyz")
+
+	// Only EVM chains are suggested for EVM lookups
+	_, err = ChainIdFromName("solana-mainet")
+	assert.ErrorIs(t, err, ErrUnknownChainName)
+	assert.NotContains(t, err.Error(), "solana-mainnet")
+	_, err = ChainIdFromName("etherum-mainnet")
+	assert.ErrorContains(t, err, "chain not found for name etherum-mainnet, did you mean ethereum-mainnet")
+
+	// Suggestions come from the chains known at the time of the lookup, and are only searched when formatting the error
+	r := NewRegistryFromData(ExtraSelectorsData{Evm: map[uint64]ChainDetails{1: {ChainSelector: 1, ChainName: "bench-mainnet"}}})
+	_, err = r.GetChainDetailsByNetworkName("bench-mainet")
+	nameErr, ok := err.(*unknownNameError)
+	require.True(t, ok)
+	assert.Empty(t, nameErr.formatted)
+	require.NoError(t, r.Unregister(FamilyEVM, "1"))
+	assert.EqualError(t, err, "chain details not found for network name bench-mainet, did you mean bench-mainnet?")
+}
+
+func TestEditDistance(t *testing.T) {
+	assert.Equal(t, 0, editDistance("", ""))
+	assert.Equal(t, 3, editDistance("", "abc"))
+	assert.Equal(t, 1, editDistance("arbitrum1", "arbitrum-1"))
+	assert.Equal(t, 3, editDistance("kitten", "sitting"))
+}
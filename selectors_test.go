@@ -265,3 +265,70 @@ func TestIsDeprecated(t *testing.T) {
 		assert.Contains(t, err.Error(), "unknown chain selector")
 	})
 }
+
+func TestGetChain(t *testing.T) {
+	var chains []ChainInfo
+	for _, ch := range ALL {
+		chains = append(chains, ch)
+	}
+	for _, ch := range SolanaALL {
+		chains = append(chains, ch)
+	}
+	for _, ch := range AptosALL {
+		chains = append(chains, ch)
+	}
+	for _, ch := range SuiALL {
+		chains = append(chains, ch)
+	}
+	for _, ch := range TonALL {
+		chains = append(chains, ch)
+	}
+	for _, ch := range TronALL {
+		chains = append(chains, ch)
+	}
+	for _, ch := range StarknetALL {
+		chains = append(chains, ch)
+	}
+	for _, ch := range CantonALL {
+		chains = append(chains, ch)
+	}
+	for _, ch := range StellarALL {
+		chains = append(chains, ch)
+	}
+	for _, ch := range CosmosALL {
+		chains = append(chains, ch)
+	}
+
+	for _, expected := range chains {
+		got, err := GetChain(expected.GetSelector())
+		require.NoError(t, err)
+		assert.Equal(t, expected, got)
+
+		details, err := GetChainDetails(expected.GetSelector())
+		require.NoError(t, err)
+		assert.Equal(t, details.NetworkType, got.GetNetworkType())
+		assert.Equal(t, details.Deprecated, got.IsDeprecated())
+
+		family, err := GetSelectorFamily(expected.GetSelector())
+		require.NoError(t, err)
+		assert.Equal(t, family, got.Family())
+
+		chainID, err := GetChainIDFromSelector(expected.GetSelector())
+		require.NoError(t, err)
+		assert.Equal(t, chainID, got.ChainIDString())
+	}
+
+	t.Run("unknown selector returns error", func(t *testing.T) {
+		_, err := GetChain(9999999999999999999)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "unknown chain selector")
+	})
+
+	t.Run("deprecated chains", func(t *testing.T) {
+		for _, ch := range ALL {
+			deprecated, err := IsDeprecated(ch.Selector)
+			require.NoError(t, err)
+			assert.Equal(t, deprecated, ch.IsDeprecated())
+		}
+	})
+}
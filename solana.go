@@ -1,132 +1,97 @@
 package chain_selectors
 
 import (
-	_ "embed"
 	"fmt"
-	"log"
 
 	"github.com/mr-tron/base58"
-	"gopkg.in/yaml.v3"
 )
 
+//go:generate go run genselectors.go
 //go:generate go run genchains_solana.go
 //go:generate go run generate_all_selectors.go
 
-//go:embed selectors_solana.yml
-var solanaSelectorsYml []byte
-
-//go:embed test_selectors_solana.yml
-var testSelectorsSolanaYml []byte
-
-var (
-	solanaSelectorsMap           = parseSolanaYml(solanaSelectorsYml)
-	solanaTestSelectorsMap       = parseSolanaYml(testSelectorsSolanaYml)
-	solanaChainIdToChainSelector = loadAllSolanaSelectors()
-	solanaChainsBySelector       = make(map[uint64]SolanaChain)
-)
+func validateSolanaChainID(data map[string]ChainDetails) error {
+	return validateChains(data, validateSolanaChain)
+}
 
-func init() {
-	// Load extra selectors
-	for chainID, chainDetails := range getExtraSelectors().Solana {
-		if _, exists := solanaSelectorsMap[chainID]; exists {
-			log.Printf("WARN: Skipping extra selector for chain %s because it already exists", chainID)
-			continue
-		}
-		solanaSelectorsMap[chainID] = chainDetails
-		solanaChainIdToChainSelector[chainID] = chainDetails
-		solanaChainsBySelector[chainDetails.ChainSelector] = SolanaChain{
-			ChainID:     chainID,
-			Selector:    chainDetails.ChainSelector,
-			Name:        chainDetails.ChainName,
-			NetworkType: chainDetails.NetworkType,
-		}
+func validateSolanaChain(genesisHash string, _ ChainDetails) error {
+	b, err := base58.Decode(genesisHash)
+	if err != nil {
+		return newLookupError(ErrInvalidChainID, err, "failed to decode base58 genesis hash %s: %v", genesisHash, err)
 	}
-
-	for _, v := range SolanaALL {
-		solanaChainsBySelector[v.Selector] = v
+	if len(b) != 32 {
+		return newLookupError(ErrInvalidChainID, nil, "decoded genesis hash %s is not 32 bytes long", genesisHash)
 	}
+	return nil
 }
 
-func loadAllSolanaSelectors() map[string]ChainDetails {
-	output := make(map[string]ChainDetails, len(solanaSelectorsMap)+len(solanaTestSelectorsMap))
-	for k, v := range solanaSelectorsMap {
-		output[k] = v
-	}
-	for k, v := range solanaTestSelectorsMap {
-		output[k] = v
+func solanaChainFromEntry(e chainEntry) SolanaChain {
+	return SolanaChain{
+		ChainID:     e.ChainID,
+		Selector:    e.ChainDetails.ChainSelector,
+		Name:        e.name(),
+		NetworkType: e.ChainDetails.NetworkType,
+		Deprecated:  e.ChainDetails.Deprecated,
 	}
-	return output
 }
 
-func parseSolanaYml(ymlFile []byte) map[string]ChainDetails {
-	type ymlData struct {
-		SelectorsBySolanaChainId map[string]ChainDetails `yaml:"selectors"`
-	}
+func (c SolanaChain) Family() string {
+	return FamilySolana
+}
 
-	var data ymlData
-	err := yaml.Unmarshal(ymlFile, &data)
-	if err != nil {
-		panic(err)
-	}
+func (c SolanaChain) ChainIDString() string {
+	return c.ChainID
+}
 
-	err = validateSolanaChainID(data.SelectorsBySolanaChainId)
-	if err != nil {
-		panic(err)
-	}
+func (c SolanaChain) GetSelector() uint64 {
+	return c.Selector
+}
 
-	return data.SelectorsBySolanaChainId
+func (c SolanaChain) GetName() string {
+	return c.Name
 }
 
-func validateSolanaChainID(data map[string]ChainDetails) error {
-	for genesisHash := range data {
-		b, err := base58.Decode(genesisHash)
-		if err != nil {
-			return fmt.Errorf("failed to decode base58 genesis hash %s: %w", genesisHash, err)
-		}
-		if len(b) != 32 {
-			return fmt.Errorf("decoded genesis hash %s is not 32 bytes long", genesisHash)
-		}
-	}
-	return nil
+func (c SolanaChain) GetNetworkType() NetworkType {
+	return c.NetworkType
+}
+
+func (c SolanaChain) IsDeprecated() bool {
+	return c.Deprecated
 }
 
 func SolanaChainIdToChainSelector() map[string]uint64 {
-	copyMap := make(map[string]uint64, len(solanaChainIdToChainSelector))
-	for k, v := range solanaChainIdToChainSelector {
-		copyMap[k] = v.ChainSelector
-	}
-	return copyMap
+	return defaultRegistry.ChainIdToChainSelector(FamilySolana)
 }
 
 func SolanaNameFromChainId(chainId string) (string, error) {
-	details, exist := solanaChainIdToChainSelector[chainId]
+	e, exist := defaultRegistry.entryByChainID(FamilySolana, chainId)
 	if !exist {
-		return "", fmt.Errorf("chain name not found for chain %v", chainId)
-	}
-	if details.ChainName == "" {
-		return chainId, nil
+		return "", newLookupError(ErrUnknownChainID{Family: FamilySolana, ChainID: fmt.Sprint(chainId)}, nil, "chain name not found for chain %v", chainId)
 	}
-	return details.ChainName, nil
+	return e.name(), nil
 }
 
 func SolanaChainIdFromSelector(selector uint64) (string, error) {
-	chain, exist := solanaChainsBySelector[selector]
+	chain, exist := SolanaChainBySelector(selector)
 	if !exist {
-		return "", fmt.Errorf("chain not found for selector %d", selector)
+		return "", newLookupError(ErrUnknownSelector, nil, "chain not found for selector %d", selector)
 	}
 
 	return chain.ChainID, nil
 }
 
 func SolanaChainBySelector(selector uint64) (SolanaChain, bool) {
-	chain, exists := solanaChainsBySelector[selector]
+	e, exists := defaultRegistry.entry(selector)
+	if !exists || e.Family != FamilySolana {
+		return SolanaChain{}, false
+	}
 
-	return chain, exists
+	return solanaChainFromEntry(e), true
 }
 
 func SolanaNetworkTypeFromChainId(chainId string) (NetworkType, error) {
-	if chainDetails, exist := solanaChainIdToChainSelector[chainId]; exist {
-		return chainDetails.NetworkType, nil
+	if e, exist := defaultRegistry.entryByChainID(FamilySolana, chainId); exist {
+		return e.ChainDetails.NetworkType, nil
 	}
-	return "", fmt.Errorf("chain network type not found for chain %v", chainId)
+	return "", newLookupError(ErrUnknownChainID{Family: FamilySolana, ChainID: fmt.Sprint(chainId)}, nil, "chain network type not found for chain %v", chainId)
 }
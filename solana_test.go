@@ -1,7 +1,6 @@
 package chain_selectors
 
 import (
-	"fmt"
 	"math/rand"
 	"testing"
 
@@ -55,7 +54,8 @@ func Test_YmlAreValid(t *testing.T) {
 }
 
 func Test_SolanaChainSelectors(t *testing.T) {
-	for selector, chain := range solanaChainsBySelector {
+	for _, chain := range SolanaALL {
+		selector := chain.Selector
 		family, err := GetSelectorFamily(selector)
 		require.NoError(t, err,
 			"selector %v should be returned as solana family, but received %v",
@@ -76,24 +76,28 @@ func Test_SolanaChainSelectors(t *testing.T) {
 }
 
 func Test_SolanaGetChainDetailsByChainIDAndFamily(t *testing.T) {
-	for k, v := range solanaSelectorsMap {
-		details, err := GetChainDetailsByChainIDAndFamily(k, FamilySolana)
+	for _, e := range solanaSelectors {
+		details, err := GetChainDetailsByChainIDAndFamily(e.ChainID, FamilySolana)
 		assert.NoError(t, err)
-		assert.Equal(t, v, details)
+		assert.Equal(t, e.ChainDetails, details)
 	}
 }
 
 func Test_SolanaGetChainIDByChainSelector(t *testing.T) {
-	for k, v := range solanaSelectorsMap {
-		chainID, err := GetChainIDFromSelector(v.ChainSelector)
+	for _, e := range solanaSelectors {
+		chainID, err := GetChainIDFromSelector(e.ChainDetails.ChainSelector)
 		assert.NoError(t, err)
-		assert.Equal(t, chainID, fmt.Sprintf("%v", k))
+		assert.Equal(t, chainID, e.ChainID)
 	}
 }
 
 func Test_SolanaNoOverlapBetweenRealAndTestChains(t *testing.T) {
-	for k, _ := range solanaSelectorsMap {
-		_, exist := solanaTestSelectorsMap[k]
-		assert.False(t, exist, "Chain %d is duplicated between real and test chains", k)
+	testChainIDs := map[string]struct{}{}
+	for _, e := range solanaTestSelectors {
+		testChainIDs[e.ChainID] = struct{}{}
+	}
+	for _, e := range solanaSelectors {
+		_, exist := testChainIDs[e.ChainID]
+		assert.False(t, exist, "Chain %s is duplicated between real and test chains", e.ChainID)
 	}
 }
@@ -1,98 +1,98 @@
 package chain_selectors
 
 import (
-	_ "embed"
 	"fmt"
-	"log"
-
-	"gopkg.in/yaml.v3"
 )
 
+//go:generate go run genselectors.go
 //go:generate go run genchains_starknet.go
 //go:generate go run generate_all_selectors.go
 
-//go:embed selectors_starknet.yml
-var starknetSelectorsYml []byte
+// starknetMaxChainIDLength is the maximum length of a Cairo short string, which must fit in a felt once hex encoded.
+const starknetMaxChainIDLength = 31
 
-var (
-	starknetSelectorsMap     = parseStarknetYml(starknetSelectorsYml)
-	starknetChainsBySelector = make(map[uint64]StarknetChain)
-)
+func validateStarknetChainID(data map[string]ChainDetails) error {
+	return validateChains(data, validateStarknetChain)
+}
 
-func init() {
-	// Load extra selectors
-	for chainID, chainDetails := range getExtraSelectors().Starknet {
-		if _, exists := starknetSelectorsMap[chainID]; exists {
-			log.Printf("WARN: Skipping extra selector for chain %s because it already exists", chainID)
-			continue
-		}
-		starknetSelectorsMap[chainID] = chainDetails
-		starknetChainsBySelector[chainDetails.ChainSelector] = StarknetChain{
-			ChainID:     chainID,
-			Selector:    chainDetails.ChainSelector,
-			Name:        chainDetails.ChainName,
-			NetworkType: chainDetails.NetworkType,
+func validateStarknetChain(chainID string, _ ChainDetails) error {
+	if chainID == "" || len(chainID) > starknetMaxChainIDLength {
+		return newLookupError(ErrInvalidChainID, nil, "invalid starknet chain ID %q: must be 1 to %d characters", chainID, starknetMaxChainIDLength)
+	}
+	for _, r := range chainID {
+		if r < 0x20 || r > 0x7e {
+			return newLookupError(ErrInvalidChainID, nil, "invalid starknet chain ID %q: must only contain printable ASCII characters", chainID)
 		}
 	}
+	return nil
+}
 
-	for _, v := range StarknetALL {
-		starknetChainsBySelector[v.Selector] = v
+func starknetChainFromEntry(e chainEntry) StarknetChain {
+	return StarknetChain{
+		ChainID:     e.ChainID,
+		Selector:    e.ChainDetails.ChainSelector,
+		Name:        e.name(),
+		NetworkType: e.ChainDetails.NetworkType,
+		Deprecated:  e.ChainDetails.Deprecated,
 	}
 }
 
-func parseStarknetYml(ymlFile []byte) map[string]ChainDetails {
-	type ymlData struct {
-		SelectorsByStarknetChainId map[string]ChainDetails `yaml:"selectors"`
-	}
+func (c StarknetChain) Family() string {
+	return FamilyStarknet
+}
 
-	var data ymlData
-	err := yaml.Unmarshal(ymlFile, &data)
-	if err != nil {
-		panic(err)
-	}
+func (c StarknetChain) ChainIDString() string {
+	return c.ChainID
+}
 
-	return data.SelectorsByStarknetChainId
+func (c StarknetChain) GetSelector() uint64 {
+	return c.Selector
 }
 
-func StarknetChainIdToChainSelector() map[string]uint64 {
-	copyMap := make(map[string]uint64, len(starknetSelectorsMap))
-	for k, v := range starknetSelectorsMap {
-		copyMap[k] = v.ChainSelector
-	}
+func (c StarknetChain) GetName() string {
+	return c.Name
+}
+
+func (c StarknetChain) GetNetworkType() NetworkType {
+	return c.NetworkType
+}
 
-	return copyMap
+func (c StarknetChain) IsDeprecated() bool {
+	return c.Deprecated
+}
+
+func StarknetChainIdToChainSelector() map[string]uint64 {
+	return defaultRegistry.ChainIdToChainSelector(FamilyStarknet)
 }
 
 func StarknetNameFromChainId(chainId string) (string, error) {
-	details, exist := starknetSelectorsMap[chainId]
+	e, exist := defaultRegistry.entryByChainID(FamilyStarknet, chainId)
 	if !exist {
-		return "", fmt.Errorf("chain name not found for chain %v", chainId)
-	}
-	if details.ChainName == "" {
-		return chainId, nil
+		return "", newLookupError(ErrUnknownChainID{Family: FamilyStarknet, ChainID: fmt.Sprint(chainId)}, nil, "chain name not found for chain %v", chainId)
 	}
-
-	return details.ChainName, nil
+	return e.name(), nil
 }
 
 func StarknetChainIdFromSelector(selector uint64) (string, error) {
-	chain, exist := starknetChainsBySelector[selector]
+	chain, exist := StarknetChainBySelector(selector)
 	if !exist {
-		return "", fmt.Errorf("chain not found for selector %d", selector)
+		return "", newLookupError(ErrUnknownSelector, nil, "chain not found for selector %d", selector)
 	}
 
 	return chain.ChainID, nil
 }
 
 func StarknetChainBySelector(selector uint64) (StarknetChain, bool) {
-	chain, exists := starknetChainsBySelector[selector]
-
-	return chain, exists
+	e, exist := defaultRegistry.entry(selector)
+	if !exist || e.Family != FamilyStarknet {
+		return StarknetChain{}, false
+	}
+	return starknetChainFromEntry(e), true
 }
 
 func StarknetNetworkTypeFromChainId(chainId string) (NetworkType, error) {
-	if chainDetails, exist := starknetSelectorsMap[chainId]; exist {
-		return chainDetails.NetworkType, nil
+	if e, exist := defaultRegistry.entryByChainID(FamilyStarknet, chainId); exist {
+		return e.ChainDetails.NetworkType, nil
 	}
-	return "", fmt.Errorf("chain network type not found for chain %v", chainId)
+	return "", newLookupError(ErrUnknownChainID{Family: FamilyStarknet, ChainID: fmt.Sprint(chainId)}, nil, "chain network type not found for chain %v", chainId)
 }
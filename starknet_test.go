@@ -1,7 +1,6 @@
 package chain_selectors
 
 import (
-	"fmt"
 	"math/rand"
 	"testing"
 
@@ -49,7 +48,8 @@ func Test_StarknetYmlAreValid(t *testing.T) {
 }
 
 func Test_StarknetChainSelectors(t *testing.T) {
-	for selector, chain := range starknetChainsBySelector {
+	for _, chain := range StarknetALL {
+		selector := chain.Selector
 		family, err := GetSelectorFamily(selector)
 		require.NoError(t, err,
 			"selector %v should be returned as starknet family, but received %v",
@@ -70,17 +70,17 @@ func Test_StarknetChainSelectors(t *testing.T) {
 }
 
 func Test_StarknetGetChainDetailsByChainIDAndFamily(t *testing.T) {
-	for k, v := range starknetSelectorsMap {
-		details, err := GetChainDetailsByChainIDAndFamily(k, FamilyStarknet)
+	for _, e := range starknetSelectors {
+		details, err := GetChainDetailsByChainIDAndFamily(e.ChainID, FamilyStarknet)
 		assert.NoError(t, err)
-		assert.Equal(t, v, details)
+		assert.Equal(t, e.ChainDetails, details)
 	}
 }
 
 func Test_StarknetGetChainIDByChainSelector(t *testing.T) {
-	for k, v := range starknetSelectorsMap {
-		chainID, err := GetChainIDFromSelector(v.ChainSelector)
+	for _, e := range starknetSelectors {
+		chainID, err := GetChainIDFromSelector(e.ChainDetails.ChainSelector)
 		assert.NoError(t, err)
-		assert.Equal(t, chainID, fmt.Sprintf("%v", k))
+		assert.Equal(t, chainID, e.ChainID)
 	}
 }
@@ -1,152 +1,124 @@
 package chain_selectors
 
 import (
-	_ "embed"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
-	"log"
-
-	"gopkg.in/yaml.v3"
+	"strings"
 )
 
+//go:generate go run genselectors.go
 //go:generate go run genchains_stellar.go
 //go:generate go run generate_all_selectors.go
 
-//go:embed selectors_stellar.yml
-var stellarSelectorsYml []byte
+// StellarChainIDFromPassphrase returns the chain ID of the Stellar network with the given passphrase,
+// which is the hex encoded SHA-256 hash of the passphrase.
+func StellarChainIDFromPassphrase(passphrase string) string {
+	hash := sha256.Sum256([]byte(passphrase))
+	return hex.EncodeToString(hash[:])
+}
 
-var (
-	stellarChainsByChainId  = parseStellarYml(stellarSelectorsYml)
-	stellarChainsBySelector = loadAllStellarSelectors(stellarChainsByChainId)
-	// Network passphrases are Stellar-specific (not part of the shared ChainDetails),
-	// so they are parsed separately. A Stellar network is defined by its passphrase and
-	// its network ID is SHA-256(passphrase); tx signing requires the passphrase string,
-	// which cannot be derived from the chain ID (the hash), hence it lives here.
-	stellarChainIdToPassphrase = parseStellarPassphrases(stellarSelectorsYml)
-)
+func validateStellarChainID(data map[string]ChainDetails) error {
+	return validateChains(data, validateStellarChain)
+}
 
-func init() {
-	// Load extra selectors
-	for chainID, chainDetails := range getExtraSelectors().Stellar {
-		if _, exists := stellarChainsByChainId[chainID]; exists {
-			log.Printf("WARN: Skipping extra selector for Stellar chain %s because it already exists", chainID)
-			continue
-		}
-		stellarChainsByChainId[chainID] = chainDetails
-		stellarChainsBySelector[chainDetails.ChainSelector] = StellarChain{
-			ChainID:     chainID,
-			Selector:    chainDetails.ChainSelector,
-			Name:        chainDetails.ChainName,
-			NetworkType: chainDetails.NetworkType,
-			Passphrase:  stellarChainIdToPassphrase[chainID],
-		}
+func validateStellarChain(chainID string, _ ChainDetails) error {
+	// Chain IDs are SHA-256 hashes of network passphrases
+	if hash, err := hex.DecodeString(chainID); err != nil || len(hash) != sha256.Size || chainID != strings.ToLower(chainID) {
+		return newLookupError(ErrInvalidChainID, err, "invalid stellar chain ID %s: must be a lowercase hex encoded SHA-256 hash", chainID)
 	}
-
-	err := validateStellarChainID(stellarChainsByChainId)
-	if err != nil {
-		panic(err)
+	if passphrase, ok := stellarPassphraseFromChainId(chainID); ok && StellarChainIDFromPassphrase(passphrase) != chainID {
+		return newLookupError(ErrInvalidChainID, nil, "invalid stellar chain ID %s: must be the SHA-256 hash of passphrase %q", chainID, passphrase)
 	}
+	return nil
 }
 
-func parseStellarYml(ymlFile []byte) map[string]ChainDetails {
-	type ymlData struct {
-		SelectorsByNetworkId map[string]ChainDetails `yaml:"selectors"`
+func stellarChainFromEntry(e chainEntry) StellarChain {
+	// Network passphrases are Stellar-specific (not part of the shared ChainDetails),
+	// so they are generated separately. A Stellar network is defined by its passphrase and
+	// its network ID is SHA-256(passphrase); tx signing requires the passphrase string,
+	// which cannot be derived from the chain ID (the hash), hence it lives here.
+	passphrase, _ := stellarPassphraseFromChainId(e.ChainID)
+	return StellarChain{
+		ChainID:     e.ChainID,
+		Selector:    e.ChainDetails.ChainSelector,
+		Name:        e.ChainDetails.ChainName,
+		NetworkType: e.ChainDetails.NetworkType,
+		Deprecated:  e.ChainDetails.Deprecated,
+		Passphrase:  passphrase,
 	}
+}
 
-	var data ymlData
-	if err := yaml.Unmarshal(ymlFile, &data); err != nil {
-		panic(err)
-	}
+func (c StellarChain) Family() string {
+	return FamilyStellar
+}
 
-	return data.SelectorsByNetworkId
+func (c StellarChain) ChainIDString() string {
+	return c.ChainID
 }
 
-func parseStellarPassphrases(ymlFile []byte) map[string]string {
-	type stellarDetails struct {
-		Passphrase string `yaml:"passphrase"`
-	}
-	type ymlData struct {
-		SelectorsByNetworkId map[string]stellarDetails `yaml:"selectors"`
-	}
+func (c StellarChain) GetSelector() uint64 {
+	return c.Selector
+}
 
-	var data ymlData
-	if err := yaml.Unmarshal(ymlFile, &data); err != nil {
-		panic(err)
-	}
+func (c StellarChain) GetName() string {
+	return c.Name
+}
 
-	out := make(map[string]string, len(data.SelectorsByNetworkId))
-	for chainID, v := range data.SelectorsByNetworkId {
-		out[chainID] = v.Passphrase
-	}
-	return out
-}
-
-func loadAllStellarSelectors(in map[string]ChainDetails) map[uint64]StellarChain {
-	output := make(map[uint64]StellarChain, len(stellarChainsByChainId))
-	for chainID, v := range in {
-		output[v.ChainSelector] = StellarChain{
-			ChainID:     chainID,
-			Selector:    v.ChainSelector,
-			Name:        v.ChainName,
-			NetworkType: v.NetworkType,
-			Passphrase:  stellarChainIdToPassphrase[chainID],
-		}
-	}
-	return output
+func (c StellarChain) GetNetworkType() NetworkType {
+	return c.NetworkType
 }
 
-func validateStellarChainID(data map[string]ChainDetails) error {
-	// Chain IDs are SHA-256 hashes of network passphrases
-	// Add validation logic if needed
-	return nil
+func (c StellarChain) IsDeprecated() bool {
+	return c.Deprecated
 }
 
 func StellarChainIdToChainSelector() map[string]uint64 {
-	copyMap := make(map[string]uint64, len(stellarChainsByChainId))
-	for k, v := range stellarChainsByChainId {
-		copyMap[k] = v.ChainSelector
-	}
-	return copyMap
+	return defaultRegistry.ChainIdToChainSelector(FamilyStellar)
 }
 
 func StellarNameFromChainId(chainID string) (string, error) {
-	details, exist := stellarChainsByChainId[chainID]
+	e, exist := defaultRegistry.entryByChainID(FamilyStellar, chainID)
 	if !exist {
-		return "", fmt.Errorf("chain name not found for chain: %v", chainID)
+		return "", newLookupError(ErrUnknownChainID{Family: FamilyStellar, ChainID: fmt.Sprint(chainID)}, nil, "chain name not found for chain: %v", chainID)
 	}
-	if details.ChainName == "" {
-		return "", fmt.Errorf("chain name is empty for chain: %v", chainID)
+	if e.ChainDetails.ChainName == "" {
+		return "", newLookupError(ErrChainNameEmpty, nil, "chain name is empty for %s chain: %v", FamilyStellar, chainID)
 	}
-	return details.ChainName, nil
+	return e.ChainDetails.ChainName, nil
 }
 
 func StellarChainIdFromSelector(selector uint64) (string, error) {
-	chain, exist := stellarChainsBySelector[selector]
+	chain, exist := StellarChainBySelector(selector)
 	if !exist {
-		return "", fmt.Errorf("chain not found for chain selector %d", selector)
+		return "", newLookupError(ErrUnknownSelector, nil, "chain not found for chain selector %d", selector)
 	}
 
 	return chain.ChainID, nil
 }
 
 func StellarChainBySelector(selector uint64) (StellarChain, bool) {
-	chain, exists := stellarChainsBySelector[selector]
-	return chain, exists
+	e, exists := defaultRegistry.entry(selector)
+	if !exists || e.Family != FamilyStellar {
+		return StellarChain{}, false
+	}
+	return stellarChainFromEntry(e), true
 }
 
 func StellarNetworkTypeFromChainId(chainId string) (NetworkType, error) {
-	if chainDetails, exist := stellarChainsByChainId[chainId]; exist {
-		return chainDetails.NetworkType, nil
+	if e, exist := defaultRegistry.entryByChainID(FamilyStellar, chainId); exist {
+		return e.ChainDetails.NetworkType, nil
 	}
-	return "", fmt.Errorf("chain network type not found for chain %v", chainId)
+	return "", newLookupError(ErrUnknownChainID{Family: FamilyStellar, ChainID: fmt.Sprint(chainId)}, nil, "chain network type not found for chain %v", chainId)
 }
 
 // StellarPassphraseFromChainId returns the network passphrase for a Stellar chain
 // ID (network ID). The network ID is SHA-256(passphrase); signing requires the
 // passphrase string, which cannot be derived from the ID.
 func StellarPassphraseFromChainId(chainID string) (string, error) {
-	passphrase, exist := stellarChainIdToPassphrase[chainID]
+	passphrase, exist := stellarPassphraseFromChainId(chainID)
 	if !exist || passphrase == "" {
-		return "", fmt.Errorf("network passphrase not found for chain: %v", chainID)
+		return "", newLookupError(ErrUnknownChainID{Family: FamilyStellar, ChainID: fmt.Sprint(chainID)}, nil, "network passphrase not found for chain: %v", chainID)
 	}
 	return passphrase, nil
 }
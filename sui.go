@@ -1,105 +1,96 @@
 package chain_selectors
 
 import (
-	_ "embed"
 	"fmt"
-	"log"
-
-	"gopkg.in/yaml.v3"
+	"strconv"
 )
 
+//go:generate go run genselectors.go
 //go:generate go run genchains_sui.go
 //go:generate go run generate_all_selectors.go
 
-//go:embed selectors_sui.yml
-var suiSelectorsYml []byte
-
-var (
-	suiSelectorsMap     = parseSuiYml(suiSelectorsYml)
-	suiChainsBySelector = make(map[uint64]SuiChain)
-)
+func validateSuiChainID(data map[uint64]ChainDetails) error {
+	return validateChains(data, validateSuiChain)
+}
 
-func init() {
-	// Load extra selectors
-	for chainID, chainDetails := range getExtraSelectors().Sui {
-		if _, exists := suiSelectorsMap[chainID]; exists {
-			log.Printf("WARN: Skipping extra selector for chain %d because it already exists", chainID)
-			continue
-		}
-		suiSelectorsMap[chainID] = chainDetails
-		suiChainsBySelector[chainDetails.ChainSelector] = SuiChain{
-			ChainID:     chainID,
-			Selector:    chainDetails.ChainSelector,
-			Name:        chainDetails.ChainName,
-			NetworkType: chainDetails.NetworkType,
-		}
+func validateSuiChain(chainID uint64, _ ChainDetails) error {
+	if chainID == 0 {
+		return newLookupError(ErrInvalidChainID, nil, "invalid sui chain ID: must be > 0")
 	}
+	return nil
+}
 
-	for _, v := range SuiALL {
-		suiChainsBySelector[v.Selector] = v
+func suiChainFromEntry(e chainEntry) SuiChain {
+	return SuiChain{
+		ChainID:     e.uintChainID(),
+		Selector:    e.ChainDetails.ChainSelector,
+		Name:        e.name(),
+		NetworkType: e.ChainDetails.NetworkType,
+		Deprecated:  e.ChainDetails.Deprecated,
 	}
 }
 
-func parseSuiYml(ymlFile []byte) map[uint64]ChainDetails {
-	type ymlData struct {
-		SelectorsBySuiChainId map[uint64]ChainDetails `yaml:"selectors"`
-	}
+func (c SuiChain) Family() string {
+	return FamilySui
+}
 
-	var data ymlData
-	err := yaml.Unmarshal(ymlFile, &data)
-	if err != nil {
-		panic(err)
-	}
+func (c SuiChain) ChainIDString() string {
+	return strconv.FormatUint(c.ChainID, 10)
+}
 
-	err = validateSuiChainID(data.SelectorsBySuiChainId)
-	if err != nil {
-		panic(err)
-	}
+func (c SuiChain) GetSelector() uint64 {
+	return c.Selector
+}
 
-	return data.SelectorsBySuiChainId
+func (c SuiChain) GetName() string {
+	return c.Name
 }
 
-func validateSuiChainID(data map[uint64]ChainDetails) error {
-	// TODO: https://smartcontract-it.atlassian.net/browse/NONEVM-890
-	return nil
+func (c SuiChain) GetNetworkType() NetworkType {
+	return c.NetworkType
+}
+
+func (c SuiChain) IsDeprecated() bool {
+	return c.Deprecated
 }
 
 func SuiChainIdToChainSelector() map[uint64]uint64 {
-	copyMap := make(map[uint64]uint64, len(suiSelectorsMap))
-	for k, v := range suiSelectorsMap {
-		copyMap[k] = v.ChainSelector
+	entries := defaultRegistry.familyEntries(FamilySui)
+	copyMap := make(map[uint64]uint64, len(entries))
+	for _, e := range entries {
+		copyMap[e.uintChainID()] = e.ChainDetails.ChainSelector
 	}
 	return copyMap
 }
 
 func SuiNameFromChainId(chainId uint64) (string, error) {
-	details, exist := suiSelectorsMap[chainId]
+	e, exist := defaultRegistry.entryByChainID(FamilySui, strconv.FormatUint(chainId, 10))
 	if !exist {
-		return "", fmt.Errorf("chain name not found for chain %v", chainId)
+		return "", newLookupError(ErrUnknownChainID{Family: FamilySui, ChainID: fmt.Sprint(chainId)}, nil, "chain name not found for chain %v", chainId)
 	}
-	if details.ChainName == "" {
-		return fmt.Sprint(chainId), nil
-	}
-	return details.ChainName, nil
+	return e.name(), nil
 }
 
 func SuiChainIdFromSelector(selector uint64) (uint64, error) {
-	chain, exist := suiChainsBySelector[selector]
+	chain, exist := SuiChainBySelector(selector)
 	if !exist {
-		return 0, fmt.Errorf("chain id not found for selector %d", selector)
+		return 0, newLookupError(ErrUnknownSelector, nil, "chain id not found for selector %d", selector)
 	}
 
 	return chain.ChainID, nil
 }
 
 func SuiChainBySelector(selector uint64) (SuiChain, bool) {
-	chain, exist := suiChainsBySelector[selector]
-	return chain, exist
+	e, exist := defaultRegistry.entry(selector)
+	if !exist || e.Family != FamilySui {
+		return SuiChain{}, false
+	}
+	return suiChainFromEntry(e), true
 }
 
 func SuiNetworkTypeFromChainId(chainId uint64) (NetworkType, error) {
-	if chainDetails, exist := suiSelectorsMap[chainId]; exist {
-		return chainDetails.NetworkType, nil
+	if e, exist := defaultRegistry.entryByChainID(FamilySui, strconv.FormatUint(chainId, 10)); exist {
+		return e.ChainDetails.NetworkType, nil
 	}
-	return "", fmt.Errorf("chain network type not found for chain %d", chainId)
+	return "", newLookupError(ErrUnknownChainID{Family: FamilySui, ChainID: fmt.Sprint(chainId)}, nil, "chain network type not found for chain %d", chainId)
 }
@@ -1,7 +1,6 @@
 package chain_selectors
 
 import (
-	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -48,7 +47,8 @@ func Test_SuiYmlAreValid(t *testing.T) {
 }
 
 func Test_SuiChainSelectors(t *testing.T) {
-	for selector, chain := range suiChainsBySelector {
+	for _, chain := range SuiALL {
+		selector := chain.Selector
 		family, err := GetSelectorFamily(selector)
 		require.NoError(t, err,
 			"selector %v should be returned as sui family, but received %v",
@@ -68,17 +68,17 @@ func Test_SuiChainSelectors(t *testing.T) {
 }
 
 func Test_SuiGetChainDetailsByChainIDAndFamily(t *testing.T) {
-	for k, v := range suiSelectorsMap {
-		details, err := GetChainDetailsByChainIDAndFamily(fmt.Sprint(k), FamilySui)
+	for _, e := range suiSelectors {
+		details, err := GetChainDetailsByChainIDAndFamily(e.ChainID, FamilySui)
 		assert.NoError(t, err)
-		assert.Equal(t, v, details)
+		assert.Equal(t, e.ChainDetails, details)
 	}
 }
 
 func Test_SuiGetChainIDByChainSelector(t *testing.T) {
-	for k, v := range suiSelectorsMap {
-		chainID, err := GetChainIDFromSelector(v.ChainSelector)
+	for _, e := range suiSelectors {
+		chainID, err := GetChainIDFromSelector(e.ChainDetails.ChainSelector)
 		assert.NoError(t, err)
-		assert.Equal(t, chainID, fmt.Sprintf("%v", k))
+		assert.Equal(t, chainID, e.ChainID)
 	}
 }
@@ -1,85 +1,97 @@
 package chain_selectors
 
 import (
-	_ "embed"
 	"fmt"
-	"log"
-
-	"gopkg.in/yaml.v3"
+	"strconv"
 )
 
+//go:generate go run genselectors.go
 //go:generate go run genchains_ton.go
 //go:generate go run generate_all_selectors.go
 
-//go:embed selectors_ton.yml
-var tonSelectorsYml []byte
-
-var (
-	tonSelectorsMap      = parseTonYml(tonSelectorsYml)
-	tonChainIdBySelector = make(map[uint64]int32)
-)
+func validateTonChainID(data map[int32]ChainDetails) error {
+	return validateChains(data, validateTonChain)
+}
 
-func init() {
-	// Load extra selectors
-	for chainID, chainDetails := range getExtraSelectors().Ton {
-		if _, exists := tonSelectorsMap[chainID]; exists {
-			log.Printf("WARN: Skipping extra selector for chain %d because it already exists", chainID)
-			continue
-		}
-		tonSelectorsMap[chainID] = chainDetails
-		tonChainIdBySelector[chainDetails.ChainSelector] = chainID
+func validateTonChain(chainID int32, _ ChainDetails) error {
+	// Chain IDs are the signed global ID of the network, e.g. -239 for the mainnet
+	if chainID == 0 {
+		return newLookupError(ErrInvalidChainID, nil, "invalid ton chain ID: must not be 0")
 	}
+	return nil
+}
 
-	for k, v := range tonSelectorsMap {
-		tonChainIdBySelector[v.ChainSelector] = k
+func tonChainFromEntry(e chainEntry) TonChain {
+	return TonChain{
+		ChainID:     e.intChainID(),
+		Selector:    e.ChainDetails.ChainSelector,
+		Name:        e.name(),
+		NetworkType: e.ChainDetails.NetworkType,
+		Deprecated:  e.ChainDetails.Deprecated,
 	}
 }
 
-func parseTonYml(ymlFile []byte) map[int32]ChainDetails {
-	type ymlData struct {
-		SelectorsByTonChainId map[int32]ChainDetails `yaml:"selectors"`
-	}
+func (c TonChain) Family() string {
+	return FamilyTon
+}
 
-	var data ymlData
-	err := yaml.Unmarshal(ymlFile, &data)
-	if err != nil {
-		panic(err)
-	}
+func (c TonChain) ChainIDString() string {
+	return strconv.FormatInt(int64(c.ChainID), 10)
+}
 
-	return data.SelectorsByTonChainId
+func (c TonChain) GetSelector() uint64 {
+	return c.Selector
+}
+
+func (c TonChain) GetName() string {
+	return c.Name
+}
+
+func (c TonChain) GetNetworkType() NetworkType {
+	return c.NetworkType
+}
+
+func (c TonChain) IsDeprecated() bool {
+	return c.Deprecated
 }
 
 func TonChainIdToChainSelector() map[int32]uint64 {
-	copyMap := make(map[int32]uint64, len(tonSelectorsMap))
-	for k, v := range tonSelectorsMap {
-		copyMap[k] = v.ChainSelector
+	entries := defaultRegistry.familyEntries(FamilyTon)
+	copyMap := make(map[int32]uint64, len(entries))
+	for _, e := range entries {
+		copyMap[e.intChainID()] = e.ChainDetails.ChainSelector
 	}
 	return copyMap
 }
 
 func TonNameFromChainId(chainId int32) (string, error) {
-	details, exist := tonSelectorsMap[chainId]
+	e, exist := defaultRegistry.entryByChainID(FamilyTon, strconv.FormatInt(int64(chainId), 10))
 	if !exist {
-		return "", fmt.Errorf("chain name not found for chain %v", chainId)
+		return "", newLookupError(ErrUnknownChainID{Family: FamilyTon, ChainID: fmt.Sprint(chainId)}, nil, "chain name not found for chain %v", chainId)
 	}
-	if details.ChainName == "" {
-		return fmt.Sprint(chainId), nil
-	}
-	return details.ChainName, nil
+	return e.name(), nil
 }
 
 func TonChainIdFromSelector(selector uint64) (int32, error) {
-	chainId, exist := tonChainIdBySelector[selector]
+	chain, exist := TonChainBySelector(selector)
 	if !exist {
-		return 0, fmt.Errorf("chain id not found for selector %d", selector)
+		return 0, newLookupError(ErrUnknownSelector, nil, "chain id not found for selector %d", selector)
 	}
 
-	return chainId, nil
+	return chain.ChainID, nil
+}
+
+func TonChainBySelector(selector uint64) (TonChain, bool) {
+	e, exist := defaultRegistry.entry(selector)
+	if !exist || e.Family != FamilyTon {
+		return TonChain{}, false
+	}
+	return tonChainFromEntry(e), true
 }
 
 func TonNetworkTypeFromChainId(chainId int32) (NetworkType, error) {
-	if chainDetails, exist := tonSelectorsMap[chainId]; exist {
-		return chainDetails.NetworkType, nil
+	if e, exist := defaultRegistry.entryByChainID(FamilyTon, strconv.FormatInt(int64(chainId), 10)); exist {
+		return e.ChainDetails.NetworkType, nil
 	}
-	return "", fmt.Errorf("chain network type not found for chain %v", chainId)
+	return "", newLookupError(ErrUnknownChainID{Family: FamilyTon, ChainID: fmt.Sprint(chainId)}, nil, "chain network type not found for chain %v", chainId)
 }
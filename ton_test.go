@@ -1,7 +1,6 @@
 package chain_selectors
 
 import (
-	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -47,7 +46,8 @@ func Test_TonYmlAreValid(t *testing.T) {
 }
 
 func Test_TonChainSelectors(t *testing.T) {
-	for selector, chainId := range tonChainIdBySelector {
+	for _, chain := range TonALL {
+		selector := chain.Selector
 		family, err := GetSelectorFamily(selector)
 		require.NoError(t, err,
 			"selector %v should be returned as ton family, but received %v",
@@ -57,22 +57,29 @@ func Test_TonChainSelectors(t *testing.T) {
 
 		id, err := TonChainIdFromSelector(selector)
 		require.Nil(t, err)
-		require.Equal(t, chainId, id)
+		require.Equal(t, chain.ChainID, id)
+
+		returnedChain, exists := TonChainBySelector(selector)
+		require.True(t, exists)
+		require.Equal(t, chain, returnedChain)
 	}
+
+	_, exists := TonChainBySelector(ETHEREUM_MAINNET.Selector)
+	require.False(t, exists)
 }
 
 func Test_TonGetChainDetailsByChainIDAndFamily(t *testing.T) {
-	for k, v := range tonSelectorsMap {
-		details, err := GetChainDetailsByChainIDAndFamily(fmt.Sprint(k), FamilyTon)
+	for _, e := range tonSelectors {
+		details, err := GetChainDetailsByChainIDAndFamily(e.ChainID, FamilyTon)
 		assert.NoError(t, err)
-		assert.Equal(t, v, details)
+		assert.Equal(t, e.ChainDetails, details)
 	}
 }
 
 func Test_TonGetChainIDByChainSelector(t *testing.T) {
-	for k, v := range tonSelectorsMap {
-		chainID, err := GetChainIDFromSelector(v.ChainSelector)
+	for _, e := range tonSelectors {
+		chainID, err := GetChainIDFromSelector(e.ChainDetails.ChainSelector)
 		assert.NoError(t, err)
-		assert.Equal(t, chainID, fmt.Sprintf("%v", k))
+		assert.Equal(t, chainID, e.ChainID)
 	}
 }
@@ -1,85 +1,96 @@
 package chain_selectors
 
 import (
-	_ "embed"
 	"fmt"
-	"log"
-
-	"gopkg.in/yaml.v3"
+	"strconv"
 )
 
+//go:generate go run genselectors.go
 //go:generate go run genchains_tron.go
 //go:generate go run generate_all_selectors.go
 
-//go:embed selectors_tron.yml
-var tronSelectorsYml []byte
-
-var (
-	tronSelectorsMap      = parseTronYml(tronSelectorsYml)
-	tronChainIdBySelector = make(map[uint64]uint64)
-)
+func validateTronChainID(data map[uint64]ChainDetails) error {
+	return validateChains(data, validateTronChain)
+}
 
-func init() {
-	// Load extra selectors
-	for chainID, chainDetails := range getExtraSelectors().Tron {
-		if _, exists := tronSelectorsMap[chainID]; exists {
-			log.Printf("WARN: Skipping extra selector for chain %d because it already exists", chainID)
-			continue
-		}
-		tronSelectorsMap[chainID] = chainDetails
-		tronChainIdBySelector[chainDetails.ChainSelector] = chainID
+func validateTronChain(chainID uint64, _ ChainDetails) error {
+	if chainID == 0 {
+		return newLookupError(ErrInvalidChainID, nil, "invalid tron chain ID: must be > 0")
 	}
+	return nil
+}
 
-	for k, v := range tronSelectorsMap {
-		tronChainIdBySelector[v.ChainSelector] = k
+func tronChainFromEntry(e chainEntry) TronChain {
+	return TronChain{
+		ChainID:     e.uintChainID(),
+		Selector:    e.ChainDetails.ChainSelector,
+		Name:        e.name(),
+		NetworkType: e.ChainDetails.NetworkType,
+		Deprecated:  e.ChainDetails.Deprecated,
 	}
 }
 
-func parseTronYml(ymlFile []byte) map[uint64]ChainDetails {
-	type ymlData struct {
-		SelectorsByTronChainId map[uint64]ChainDetails `yaml:"selectors"`
-	}
+func (c TronChain) Family() string {
+	return FamilyTron
+}
 
-	var data ymlData
-	err := yaml.Unmarshal(ymlFile, &data)
-	if err != nil {
-		panic(err)
-	}
+func (c TronChain) ChainIDString() string {
+	return strconv.FormatUint(c.ChainID, 10)
+}
 
-	return data.SelectorsByTronChainId
+func (c TronChain) GetSelector() uint64 {
+	return c.Selector
+}
+
+func (c TronChain) GetName() string {
+	return c.Name
+}
+
+func (c TronChain) GetNetworkType() NetworkType {
+	return c.NetworkType
+}
+
+func (c TronChain) IsDeprecated() bool {
+	return c.Deprecated
 }
 
 func TronChainIdToChainSelector() map[uint64]uint64 {
-	copyMap := make(map[uint64]uint64, len(tronSelectorsMap))
-	for k, v := range tronSelectorsMap {
-		copyMap[k] = v.ChainSelector
+	entries := defaultRegistry.familyEntries(FamilyTron)
+	copyMap := make(map[uint64]uint64, len(entries))
+	for _, e := range entries {
+		copyMap[e.uintChainID()] = e.ChainDetails.ChainSelector
 	}
 	return copyMap
 }
 
 func TronNameFromChainId(chainId uint64) (string, error) {
-	details, exist := tronSelectorsMap[chainId]
+	e, exist := defaultRegistry.entryByChainID(FamilyTron, strconv.FormatUint(chainId, 10))
 	if !exist {
-		return "", fmt.Errorf("chain name not found for chain %v", chainId)
+		return "", newLookupError(ErrUnknownChainID{Family: FamilyTron, ChainID: fmt.Sprint(chainId)}, nil, "chain name not found for chain %v", chainId)
 	}
-	if details.ChainName == "" {
-		return fmt.Sprint(chainId), nil
-	}
-	return details.ChainName, nil
+	return e.name(), nil
 }
 
 func TronChainIdFromSelector(selector uint64) (uint64, error) {
-	chainId, exist := tronChainIdBySelector[selector]
+	chain, exist := TronChainBySelector(selector)
 	if !exist {
-		return 0, fmt.Errorf("chain id not found for selector %d", selector)
+		return 0, newLookupError(ErrUnknownSelector, nil, "chain id not found for selector %d", selector)
 	}
 
-	return chainId, nil
+	return chain.ChainID, nil
+}
+
+func TronChainBySelector(selector uint64) (TronChain, bool) {
+	e, exist := defaultRegistry.entry(selector)
+	if !exist || e.Family != FamilyTron {
+		return TronChain{}, false
+	}
+	return tronChainFromEntry(e), true
 }
 
 func TronNetworkTypeFromChainId(chainId uint64) (NetworkType, error) {
-	if chainDetails, exist := tronSelectorsMap[chainId]; exist {
-		return chainDetails.NetworkType, nil
+	if e, exist := defaultRegistry.entryByChainID(FamilyTron, strconv.FormatUint(chainId, 10)); exist {
+		return e.ChainDetails.NetworkType, nil
 	}
-	return "", fmt.Errorf("chain network type not found for chain %v", chainId)
+	return "", newLookupError(ErrUnknownChainID{Family: FamilyTron, ChainID: fmt.Sprint(chainId)}, nil, "chain network type not found for chain %v", chainId)
 }
@@ -1,7 +1,6 @@
 package chain_selectors
 
 import (
-	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -54,7 +53,8 @@ func Test_TronYmlAreValid(t *testing.T) {
 }
 
 func Test_TronChainSelectors(t *testing.T) {
-	for selector, chainId := range tronChainIdBySelector {
+	for _, chain := range TronALL {
+		selector := chain.Selector
 		family, err := GetSelectorFamily(selector)
 		require.NoError(t, err,
 			"selector %v should be returned as tron family, but received %v",
@@ -64,22 +64,29 @@ func Test_TronChainSelectors(t *testing.T) {
 
 		id, err := TronChainIdFromSelector(selector)
 		require.Nil(t, err)
-		require.Equal(t, chainId, id)
+		require.Equal(t, chain.ChainID, id)
+
+		returnedChain, exists := TronChainBySelector(selector)
+		require.True(t, exists)
+		require.Equal(t, chain, returnedChain)
 	}
+
+	_, exists := TronChainBySelector(ETHEREUM_MAINNET.Selector)
+	require.False(t, exists)
 }
 
 func Test_TronGetChainDetailsByChainIDAndFamily(t *testing.T) {
-	for k, v := range tronSelectorsMap {
-		details, err := GetChainDetailsByChainIDAndFamily(fmt.Sprint(k), FamilyTron)
+	for _, e := range tronSelectors {
+		details, err := GetChainDetailsByChainIDAndFamily(e.ChainID, FamilyTron)
 		assert.NoError(t, err)
-		assert.Equal(t, v, details)
+		assert.Equal(t, e.ChainDetails, details)
 	}
 }
 
 func Test_TronGetChainIDByChainSelector(t *testing.T) {
-	for k, v := range tronSelectorsMap {
-		chainID, err := GetChainIDFromSelector(v.ChainSelector)
+	for _, e := range tronSelectors {
+		chainID, err := GetChainIDFromSelector(e.ChainDetails.ChainSelector)
 		assert.NoError(t, err)
-		assert.Equal(t, chainID, fmt.Sprintf("%v", k))
+		assert.Equal(t, chainID, e.ChainID)
 	}
 }
@@ -28,3 +28,16 @@ type ChainDetails struct {
 	// Deprecated marks chains that have been sunset or superseded by a newer version.
 	Deprecated bool `yaml:"deprecated,omitempty"`
 }
+
+// ChainInfo is implemented by the generated chain structs of every family, e.g. Chain,
+// SolanaChain or TonChain, so that chains can be handled without switching on their type.
+type ChainInfo interface {
+	// Family returns the chain family, e.g. FamilyEVM.
+	Family() string
+	// ChainIDString returns the chain ID formatted the same way as GetChainIDFromSelector.
+	ChainIDString() string
+	GetSelector() uint64
+	GetName() string
+	GetNetworkType() NetworkType
+	IsDeprecated() bool
+}
@@ -0,0 +1,106 @@
+package chain_selectors
+
+import (
+	"cmp"
+	"fmt"
+	"sort"
+)
+
+// ValidationError is a chain breaking a validation rule, see Validate.
+type ValidationError struct {
+	Family  string
+	ChainID string
+	Err     error
+}
+
+func (e ValidationError) Error() string {
+	return fmt.Sprintf("%s chain %s: %v", e.Family, e.ChainID, e.Err)
+}
+
+func (e ValidationError) Unwrap() error {
+	return e.Err
+}
+
+// Validate checks every chain of data and returns a ValidationError for each invalid chain, by family in the
+// order of the fields of ExtraSelectorsData, then by chain ID. It is used for the embedded selector files, extra selectors and remote selectors alike.
+//
+// Every chain must have a selector other than 0 and, if set, a network type of mainnet or testnet. Selectors
+// and names must not be used by another chain of the same family, the later chain ID being reported.
+// The chain ID must also be valid for the family:
+//   - EVM, Aptos, Sui and Tron chain IDs are greater than 0
+//   - Solana chain IDs are base58 encoded 32 bytes genesis hashes
+//   - Ton chain IDs are signed global IDs other than 0
+//   - Starknet chain IDs are short strings of at most 31 ASCII characters, hex encoded into a felt on chain
+//   - Stellar chain IDs are hex encoded SHA-256 hashes of the network passphrase
+//   - Cosmos and Canton chain IDs are not empty and don't contain whitespace
+//
+// Aptos chains must also have a name and a network type.
+func Validate(data ExtraSelectorsData) []ValidationError {
+	var errs []ValidationError
+	errs = append(errs, validateFamily(FamilyEVM, data.Evm, validateEVMChain, formatUint)...)
+	errs = append(errs, validateFamily(FamilyAptos, data.Aptos, validateAptosChain, formatUint)...)
+	errs = append(errs, validateFamily(FamilySolana, data.Solana, validateSolanaChain, formatString)...)
+	errs = append(errs, validateFamily(FamilySui, data.Sui, validateSuiChain, formatUint)...)
+	errs = append(errs, validateFamily(FamilyTon, data.Ton, validateTonChain, formatInt)...)
+	errs = append(errs, validateFamily(FamilyTron, data.Tron, validateTronChain, formatUint)...)
+	errs = append(errs, validateFamily(FamilyStarknet, data.Starknet, validateStarknetChain, formatString)...)
+	errs = append(errs, validateFamily(FamilyCanton, data.Canton, validateCantonChain, formatString)...)
+	errs = append(errs, validateFamily(FamilyStellar, data.Stellar, validateStellarChain, formatString)...)
+	errs = append(errs, validateFamily(FamilyCosmos, data.Cosmos, validateCosmosChain, formatString)...)
+	return errs
+}
+
+// validateFamily checks the chains of a family in chain ID order, so that duplicates are reported on the
+// same chain every time. validate checks the chain ID for the family.
+func validateFamily[K cmp.Ordered](family string, chains map[K]ChainDetails, validate func(K, ChainDetails) error, format func(K) string) []ValidationError {
+	chainIDs := make([]K, 0, len(chains))
+	for chainID := range chains {
+		chainIDs = append(chainIDs, chainID)
+	}
+	sort.Slice(chainIDs, func(i, j int) bool { return chainIDs[i] < chainIDs[j] })
+
+	var errs []ValidationError
+	selectors := make(map[uint64]string, len(chains))
+	names := make(map[string]string, len(chains))
+	for _, chainID := range chainIDs {
+		details := chains[chainID]
+		err := validateChainDetails(details)
+		if err == nil {
+			err = validate(chainID, details)
+		}
+		if other, exists := selectors[details.ChainSelector]; err == nil && exists {
+			err = newLookupError(ErrSelectorInUse, nil, "selector %d is already used by %s chain %s", details.ChainSelector, family, other)
+		}
+		if other, exists := names[details.ChainName]; err == nil && exists && details.ChainName != "" {
+			err = newLookupError(ErrNameInUse, nil, "name %s is already used by %s chain %s", details.ChainName, family, other)
+		}
+		if err != nil {
+			errs = append(errs, ValidationError{Family: family, ChainID: format(chainID), Err: err})
+			continue
+		}
+		selectors[details.ChainSelector] = format(chainID)
+		names[details.ChainName] = format(chainID)
+	}
+	return errs
+}
+
+// validateChains checks every chain of a family with validate, returning the first error.
+func validateChains[K comparable](chains map[K]ChainDetails, validate func(K, ChainDetails) error) error {
+	for chainID, details := range chains {
+		if err := validate(chainID, details); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateChainDetails checks the rules shared by the chains of every family.
+func validateChainDetails(details ChainDetails) error {
+	if details.ChainSelector == 0 {
+		return newLookupError(ErrInvalidSelector, nil, "invalid chain selector: must be > 0")
+	}
+	if details.NetworkType != "" && details.NetworkType != NetworkTypeTestnet && details.NetworkType != NetworkTypeMainnet {
+		return newLookupError(ErrInvalidNetworkType, nil, "invalid network type %q: must be %q or %q", details.NetworkType, NetworkTypeTestnet, NetworkTypeMainnet)
+	}
+	return nil
+}
@@ -0,0 +1,123 @@
+package chain_selectors
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidate(t *testing.T) {
+	t.Run("valid data passes", func(t *testing.T) {
+		assert.Empty(t, Validate(ExtraSelectorsData{
+			Evm:      map[uint64]ChainDetails{1: {ChainSelector: 1, ChainName: "evm-mainnet", NetworkType: NetworkTypeMainnet}, 2: {ChainSelector: 2}},
+			Aptos:    map[uint64]ChainDetails{1: {ChainSelector: 3, ChainName: "aptos-mainnet", NetworkType: NetworkTypeMainnet}},
+			Solana:   map[string]ChainDetails{"ASwXBTzJM5evpfrWSHSjZaxPErZRuiGJnFixGUHi4NQT": {ChainSelector: 4}},
+			Sui:      map[uint64]ChainDetails{1: {ChainSelector: 5}},
+			Ton:      map[int32]ChainDetails{-239: {ChainSelector: 6}},
+			Tron:     map[uint64]ChainDetails{728126428: {ChainSelector: 7}},
+			Starknet: map[string]ChainDetails{"SN_MAIN": {ChainSelector: 8}},
+			Canton:   map[string]ChainDetails{"MainNet": {ChainSelector: 9}},
+			Stellar:  map[string]ChainDetails{StellarChainIDFromPassphrase("Test Network"): {ChainSelector: 10}},
+			Cosmos:   map[string]ChainDetails{"cosmoshub-4": {ChainSelector: 11}},
+		}))
+	})
+
+	tests := []struct {
+		name     string
+		data     ExtraSelectorsData
+		expected string
+	}{
+		{
+			name:     "zero selector",
+			data:     ExtraSelectorsData{Sui: map[uint64]ChainDetails{1: {ChainName: "sui-mainnet"}}},
+			expected: "sui chain 1: invalid chain selector: must be > 0",
+		},
+		{
+			name:     "invalid network type",
+			data:     ExtraSelectorsData{Tron: map[uint64]ChainDetails{1: {ChainSelector: 1, NetworkType: "devnet"}}},
+			expected: `tron chain 1: invalid network type "devnet": must be "testnet" or "mainnet"`,
+		},
+		{
+			name:     "duplicate selector",
+			data:     ExtraSelectorsData{Aptos: map[uint64]ChainDetails{2: {ChainSelector: 100, ChainName: "aptos-testnet", NetworkType: NetworkTypeTestnet}, 1: {ChainSelector: 100, ChainName: "aptos-mainnet", NetworkType: NetworkTypeMainnet}}},
+			expected: "aptos chain 2: selector 100 is already used by aptos chain 1",
+		},
+		{
+			name:     "duplicate name",
+			data:     ExtraSelectorsData{Ton: map[int32]ChainDetails{-3: {ChainSelector: 1, ChainName: "ton-testnet"}, -239: {ChainSelector: 2, ChainName: "ton-testnet"}}},
+			expected: "ton chain -3: name ton-testnet is already used by ton chain -239",
+		},
+		{
+			name:     "zero evm chain ID",
+			data:     ExtraSelectorsData{Evm: map[uint64]ChainDetails{0: {ChainSelector: 1}}},
+			expected: "evm chain 0: invalid evm chain ID: must be > 0",
+		},
+		{
+			name:     "solana genesis hash too short",
+			data:     ExtraSelectorsData{Solana: map[string]ChainDetails{"abc": {ChainSelector: 1}}},
+			expected: "solana chain abc: decoded genesis hash abc is not 32 bytes long",
+		},
+		{
+			name:     "zero ton chain ID",
+			data:     ExtraSelectorsData{Ton: map[int32]ChainDetails{0: {ChainSelector: 1}}},
+			expected: "ton chain 0: invalid ton chain ID: must not be 0",
+		},
+		{
+			name:     "starknet chain ID too long",
+			data:     ExtraSelectorsData{Starknet: map[string]ChainDetails{strings.Repeat("A", 32): {ChainSelector: 1}}},
+			expected: "starknet chain " + strings.Repeat("A", 32) + ": invalid starknet chain ID \"" + strings.Repeat("A", 32) + "\": must be 1 to 31 characters",
+		},
+		{
+			name:     "starknet chain ID not ascii",
+			data:     ExtraSelectorsData{Starknet: map[string]ChainDetails{"SN_MAÏN": {ChainSelector: 1}}},
+			expected: `starknet chain SN_MAÏN: invalid starknet chain ID "SN_MAÏN": must only contain printable ASCII characters`,
+		},
+		{
+			name:     "stellar chain ID not a hash",
+			data:     ExtraSelectorsData{Stellar: map[string]ChainDetails{"testnet": {ChainSelector: 1}}},
+			expected: "stellar chain testnet: invalid stellar chain ID testnet: must be a lowercase hex encoded SHA-256 hash",
+		},
+		{
+			name:     "stellar chain ID in uppercase",
+			data:     ExtraSelectorsData{Stellar: map[string]ChainDetails{strings.ToUpper(STELLAR_MAINNET.ChainID): {ChainSelector: 1}}},
+			expected: "stellar chain " + strings.ToUpper(STELLAR_MAINNET.ChainID) + ": invalid stellar chain ID " + strings.ToUpper(STELLAR_MAINNET.ChainID) + ": must be a lowercase hex encoded SHA-256 hash",
+		},
+		{
+			name:     "canton chain ID with whitespace",
+			data:     ExtraSelectorsData{Canton: map[string]ChainDetails{"Main Net": {ChainSelector: 1}}},
+			expected: `canton chain Main Net: invalid canton chain ID "Main Net": must not contain whitespace`,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			errs := Validate(test.data)
+			require.Len(t, errs, 1)
+			assert.EqualError(t, errs[0], test.expected)
+		})
+	}
+
+	t.Run("chain ID errors wrap ErrInvalidChainID", func(t *testing.T) {
+		errs := Validate(ExtraSelectorsData{Evm: map[uint64]ChainDetails{0: {ChainSelector: 1}}})
+		require.Len(t, errs, 1)
+		assert.ErrorIs(t, errs[0], ErrInvalidChainID)
+	})
+
+	t.Run("errors are sorted by family and chain ID", func(t *testing.T) {
+		errs := Validate(ExtraSelectorsData{
+			Cosmos: map[string]ChainDetails{"b": {}, "a": {}},
+			Evm:    map[uint64]ChainDetails{10: {}, 9: {}},
+		})
+		var chains []string
+		for _, err := range errs {
+			chains = append(chains, err.Family+" "+err.ChainID)
+		}
+		assert.Equal(t, []string{"evm 9", "evm 10", "cosmos a", "cosmos b"}, chains)
+	})
+}
+
+func TestStellarChainIDFromPassphrase(t *testing.T) {
+	assert.Equal(t, STELLAR_MAINNET.ChainID, StellarChainIDFromPassphrase(STELLAR_MAINNET.Passphrase))
+	assert.Equal(t, STELLAR_TESTNET.ChainID, StellarChainIDFromPassphrase(STELLAR_TESTNET.Passphrase))
+}